@@ -0,0 +1,99 @@
+package helius
+
+import "fmt"
+
+// publicKeySize is the length in bytes of a Solana public key.
+const publicKeySize = 32
+
+// base58Alphabet is the Bitcoin base58 alphabet used by Solana addresses.
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// base58Index maps an alphabet byte to its value, or -1 if it is not part of
+// the alphabet.
+var base58Index = func() [256]int {
+	var idx [256]int
+	for i := range idx {
+		idx[i] = -1
+	}
+	for i := 0; i < len(base58Alphabet); i++ {
+		idx[base58Alphabet[i]] = i
+	}
+	return idx
+}()
+
+// ValidatePublicKey checks that s is a base58-encoded 32-byte Solana public
+// key. It returns a *ValidationError describing the problem otherwise.
+//
+//	if err := helius.ValidatePublicKey(owner); err != nil {
+//	    return err
+//	}
+func ValidatePublicKey(s string) error {
+	return validatePublicKey("address", s)
+}
+
+// validatePublicKey is ValidatePublicKey with the error attributed to field.
+func validatePublicKey(field, s string) error {
+	_, err := decode32(field, "address", s)
+	return err
+}
+
+// decode32 decodes s as a base58-encoded 32-byte value, such as an address
+// or a hash, attributing any error to field. kind names the value in error
+// messages.
+func decode32(field, kind, s string) ([publicKeySize]byte, error) {
+	var key [publicKeySize]byte
+	if s == "" {
+		return key, &ValidationError{Field: field, Message: kind + " is required"}
+	}
+	decoded, err := decodeBase58(s)
+	if err != nil {
+		return key, &ValidationError{Field: field, Message: err.Error()}
+	}
+	if len(decoded) != publicKeySize {
+		return key, &ValidationError{
+			Field:   field,
+			Message: fmt.Sprintf("%s decodes to %d bytes, want %d", kind, len(decoded), publicKeySize),
+		}
+	}
+	copy(key[:], decoded)
+	return key, nil
+}
+
+// checkAddress validates s as a public key when strict validation
+// is enabled, and is a no-op otherwise.
+func (c *Client) checkAddress(field, s string) error {
+	if !c.strict {
+		return nil
+	}
+	return validatePublicKey(field, s)
+}
+
+// decodeBase58 decodes a base58 string. Leading '1' characters decode to
+// leading zero bytes.
+func decodeBase58(s string) ([]byte, error) {
+	var zeros int
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+
+	// Big-endian base256 accumulator; log(58)/log(256) < 0.733.
+	out := make([]byte, 0, len(s)*733/1000+1)
+	for i := zeros; i < len(s); i++ {
+		v := base58Index[s[i]]
+		if v < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q at position %d", s[i], i)
+		}
+		carry := v
+		for j := len(out) - 1; j >= 0; j-- {
+			carry += int(out[j]) * 58
+			out[j] = byte(carry)
+			carry >>= 8
+		}
+		for carry > 0 {
+			out = append([]byte{byte(carry)}, out...)
+			carry >>= 8
+		}
+	}
+
+	return append(make([]byte, zeros), out...), nil
+}
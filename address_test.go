@@ -0,0 +1,110 @@
+package helius
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestValidatePublicKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{name: "system program", input: "11111111111111111111111111111111"},
+		{name: "wrapped SOL", input: "So11111111111111111111111111111111111111112"},
+		{name: "USDC mint", input: "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"},
+		{name: "empty", input: "", wantErr: "address is required"},
+		{name: "too short", input: "EPjFWdd5AufqSSqe", wantErr: "want 32"},
+		{name: "too long", input: "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1vEPjF", wantErr: "want 32"},
+		{name: "zero character", input: "0PjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", wantErr: "invalid base58 character '0'"},
+		{name: "capital O", input: "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDtOv", wantErr: "invalid base58 character 'O'"},
+		{name: "lowercase l", input: "lPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", wantErr: "invalid base58 character 'l'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePublicKey(tt.input)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidatePublicKey(%q) returned error: %v", tt.input, err)
+				}
+				return
+			}
+
+			valErr, ok := IsValidationError(err)
+			if !ok {
+				t.Fatalf("ValidatePublicKey(%q) = %v, want ValidationError", tt.input, err)
+			}
+			if valErr.Field != "address" {
+				t.Errorf("Field = %q, want address", valErr.Field)
+			}
+			if !strings.Contains(valErr.Message, tt.wantErr) {
+				t.Errorf("Message = %q, want it to contain %q", valErr.Message, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStrictAddressValidation(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		json.NewEncoder(w).Encode(TokenHoldersPage{})
+	}))
+	defer server.Close()
+
+	t.Run("off by default", func(t *testing.T) {
+		calls = 0
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		if _, err := client.GetTokenHolders(context.Background(), "not-a-mint", nil); err != nil {
+			t.Fatalf("GetTokenHolders returned error: %v", err)
+		}
+		if calls != 1 {
+			t.Errorf("calls = %d, want 1", calls)
+		}
+	})
+
+	t.Run("rejects bad address without a request", func(t *testing.T) {
+		calls = 0
+		client, _ := NewClient("test-key", WithAPIURL(server.URL), WithStrictValidation(true))
+
+		_, err := client.GetTokenHolders(context.Background(), "not-a-mint", nil)
+		valErr, ok := IsValidationError(err)
+		if !ok {
+			t.Fatalf("err = %v, want ValidationError", err)
+		}
+		if valErr.Field != "mint" {
+			t.Errorf("Field = %q, want mint", valErr.Field)
+		}
+
+		_, err = client.GetAssetsByOwner(context.Background(), "short", nil)
+		if valErr, ok := IsValidationError(err); !ok || valErr.Field != "ownerAddress" {
+			t.Errorf("GetAssetsByOwner err = %v, want ValidationError for ownerAddress", err)
+		}
+
+		_, err = client.GetPriorityFeeEstimate(context.Background(), []string{"11111111111111111111111111111111", "bad"}, nil)
+		if valErr, ok := IsValidationError(err); !ok || valErr.Field != "accountKeys[1]" {
+			t.Errorf("GetPriorityFeeEstimate err = %v, want ValidationError for accountKeys[1]", err)
+		}
+
+		if calls != 0 {
+			t.Errorf("calls = %d, want 0", calls)
+		}
+	})
+
+	t.Run("accepts valid address", func(t *testing.T) {
+		calls = 0
+		client, _ := NewClient("test-key", WithAPIURL(server.URL), WithStrictValidation(true))
+		if _, err := client.GetTokenHolders(context.Background(), "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", nil); err != nil {
+			t.Fatalf("GetTokenHolders returned error: %v", err)
+		}
+		if calls != 1 {
+			t.Errorf("calls = %d, want 1", calls)
+		}
+	})
+}
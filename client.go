@@ -1,11 +1,20 @@
 package helius
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
@@ -21,6 +30,58 @@ const (
 	Devnet Network = "devnet"
 )
 
+// networkURLs holds the API and RPC base URLs of a network.
+type networkURLs struct {
+	apiURL string
+	rpcURL string
+}
+
+var (
+	networksMu sync.RWMutex
+	networks   = map[Network]networkURLs{
+		Mainnet: {apiURL: DefaultMainnetAPIURL, rpcURL: DefaultMainnetRPCURL},
+		Devnet:  {apiURL: DefaultDevnetAPIURL, rpcURL: DefaultDevnetRPCURL},
+	}
+)
+
+// RegisterNetwork registers a custom network, such as a staging cluster, so
+// that WithNetwork(name) resolves both of its URLs.
+//
+// Registering Mainnet or Devnet overrides the built-in URLs. It is safe to
+// call concurrently, but is typically done once during program start-up.
+//
+// Example:
+//
+//	helius.RegisterNetwork("staging", "https://api.staging.example.com/v0", "https://rpc.staging.example.com")
+//	client, err := helius.NewClient(apiKey, helius.WithNetwork("staging"))
+func RegisterNetwork(name Network, apiURL, rpcURL string) {
+	networksMu.Lock()
+	defer networksMu.Unlock()
+	networks[name] = networkURLs{apiURL: apiURL, rpcURL: rpcURL}
+}
+
+// lookupNetwork returns the URLs registered for name.
+func lookupNetwork(name Network) (networkURLs, bool) {
+	networksMu.RLock()
+	defer networksMu.RUnlock()
+	urls, ok := networks[name]
+	return urls, ok
+}
+
+// ResolveURLs returns the API and RPC base URLs that NewClient uses for
+// network, including networks added with RegisterNetwork, so tooling can
+// derive related endpoints without hardcoding the defaults.
+//
+// Unknown networks resolve to the mainnet URLs. Note that NewClient is
+// stricter and rejects them with a *ValidationError.
+func ResolveURLs(network Network) (apiURL, rpcURL string) {
+	urls, ok := lookupNetwork(network)
+	if !ok {
+		urls, _ = lookupNetwork(Mainnet)
+	}
+	return urls.apiURL, urls.rpcURL
+}
+
 const (
 	// DefaultMainnetAPIURL is the default Helius API URL for mainnet.
 	DefaultMainnetAPIURL = "https://api.helius.xyz/v0"
@@ -40,6 +101,12 @@ const (
 	DefaultRetryWaitMin = 500 * time.Millisecond
 	// DefaultRetryWaitMax is the maximum wait time between retries.
 	DefaultRetryWaitMax = 5 * time.Second
+	// DefaultPageRetries is the default number of times auto-paginating
+	// methods retry a failed page before giving up.
+	DefaultPageRetries = 2
+	// DefaultFanoutConcurrency is the default number of concurrent requests
+	// made by fan-out helpers such as GetAssetsByOwners.
+	DefaultFanoutConcurrency = 4
 )
 
 // Logger interface for optional logging.
@@ -65,16 +132,35 @@ type config struct {
 	rpcURL       string
 	timeout      time.Duration
 	maxRetries   int
+	pageRetries  int
 	retryWaitMin time.Duration
 	retryWaitMax time.Duration
 	httpClient   *http.Client
+	transport    http.RoundTripper
 	logger       Logger
+	clock        clock
+	debugBodies  bool
+	onHeaders    func(path string, h http.Header)
+	compress     bool
+	strict       bool
+	strictJSON   bool
+	cuBuffer     float64
+	minFee       float64
+	fanout       int
+	noRetries    bool
+	proxyURL     string
+	insecure     bool
+	maxPages     int
+
+	maxIdleConnsPerHost int
+	maxConnsPerHost     int
 }
 
 // Option configures the client.
 type Option func(*config)
 
-// WithNetwork sets the Solana network (Mainnet or Devnet).
+// WithNetwork sets the Solana network: Mainnet, Devnet, or a network added
+// with RegisterNetwork.
 func WithNetwork(network Network) Option {
 	return func(c *config) {
 		c.network = network
@@ -109,6 +195,80 @@ func WithMaxRetries(n int) Option {
 	}
 }
 
+// WithPageRetries sets how many times auto-paginating methods such as
+// GetAllTokenHolders retry a failed page before giving up. These retries are
+// in addition to the per-request retries configured by WithMaxRetries.
+func WithPageRetries(n int) Option {
+	return func(c *config) {
+		c.pageRetries = n
+	}
+}
+
+// WithStrictValidation makes methods check their inputs locally
+// before making a request: Solana addresses, as taken by GetAssetsByOwner and
+// GetTokenHolders, are checked with ValidatePublicKey, serialized
+// transactions with IsValidTransactionEncoding, and enumerated filters such as
+// SearchAssetsOptions.TokenType must be known values. It is off by default.
+func WithStrictValidation(enabled bool) Option {
+	return func(c *config) {
+		c.strict = enabled
+	}
+}
+
+// WithStrictJSON makes response decoding fail when Helius returns fields the
+// package does not model, e.g. to detect API changes in CI. The error names
+// the first unknown field found. Values the package keeps as generic JSON,
+// such as Asset.Content.Metadata, are not checked.
+//
+// It is off by default, and should stay off in production so that new API
+// fields do not break existing code.
+func WithStrictJSON() Option {
+	return func(c *config) {
+		c.strictJSON = true
+	}
+}
+
+// WithComputeUnitBuffer sets the safety margin RecommendedPriorityFee and
+// PriorityFeeTable add to the compute-unit estimate, as a fraction: 0.1
+// assumes 10% more compute units than requested. The default is zero.
+// NewClient returns a *ValidationError if pct is negative, NaN or infinite.
+func WithComputeUnitBuffer(pct float64) Option {
+	return func(c *config) {
+		c.cuBuffer = pct
+	}
+}
+
+// WithMinPriorityFee sets a floor, in microlamports per compute unit, for
+// estimates returned by GetPriorityFeeEstimate and
+// GetPriorityFeeEstimateForTransaction, so that a zero or missing estimate
+// during quiet periods does not produce a transaction with no priority fee.
+// It applies to PriorityFeeEstimate and to each of PriorityFeeLevels. The
+// default is zero, which leaves estimates as returned.
+func WithMinPriorityFee(microLamports float64) Option {
+	return func(c *config) {
+		c.minFee = microLamports
+	}
+}
+
+// WithMaxPages caps how many pages auto-paginating methods such as
+// GetAllTokenHolders and StreamAssetsByOwner fetch. When more pages remain
+// after n, they stop with ErrMaxPagesExceeded. Zero, the default, means no
+// limit.
+func WithMaxPages(n int) Option {
+	return func(c *config) {
+		c.maxPages = n
+	}
+}
+
+// WithFanoutConcurrency sets how many requests fan-out helpers such as
+// GetAssetsByOwners and GetPriorityFeeEstimates run at once. The default is DefaultFanoutConcurrency;
+// values below 1 are treated as 1.
+func WithFanoutConcurrency(n int) Option {
+	return func(c *config) {
+		c.fanout = n
+	}
+}
+
 // WithHTTPClient sets a custom HTTP client.
 func WithHTTPClient(client *http.Client) Option {
 	return func(c *config) {
@@ -116,6 +276,79 @@ func WithHTTPClient(client *http.Client) Option {
 	}
 }
 
+// WithoutRetries disables retries entirely. Requests go through a plain
+// http.Client with the configured timeout and transport, so errors have
+// standard net/http semantics, and auto-paginating methods do not retry
+// failed pages either. Use it when retries are handled upstream.
+func WithoutRetries() Option {
+	return func(c *config) {
+		c.noRetries = true
+	}
+}
+
+// WithTransport sets the http.RoundTripper used beneath the built-in retry
+// client.
+//
+// Unlike WithHTTPClient, which replaces the retrying client entirely, the
+// transport is wrapped by retryablehttp: every attempt, including retries of
+// 429 and 5xx responses, is a separate RoundTrip call on transport, and the
+// timeout and retry settings still apply. This makes it suitable for
+// injecting canned responses in tests. It is ignored when WithHTTPClient is
+// also used.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *config) {
+		c.transport = transport
+	}
+}
+
+// WithProxy routes requests through the HTTP proxy at proxyURL, keeping the
+// retry configuration intact. It applies to the default transport or one set
+// with WithTransport, which must then be an *http.Transport, and is ignored
+// when WithHTTPClient is used. NewClient returns a *ValidationError if
+// proxyURL does not parse.
+func WithProxy(proxyURL string) Option {
+	return func(c *config) {
+		c.proxyURL = proxyURL
+	}
+}
+
+// WithMaxIdleConnsPerHost sets how many idle connections to the API are kept
+// open for reuse. The net/http default of 2 forces new connections when more
+// requests than that run concurrently, so raise it to about the number of
+// concurrent calls a service makes. It applies to the default transport or an
+// *http.Transport set with WithTransport, and is ignored when WithHTTPClient
+// is used.
+func WithMaxIdleConnsPerHost(n int) Option {
+	return func(c *config) {
+		c.maxIdleConnsPerHost = n
+	}
+}
+
+// WithMaxConnsPerHost caps the number of connections to the API, including
+// those in use; further requests wait for a free connection. Zero means no
+// limit. Like WithMaxIdleConnsPerHost, it is ignored when WithHTTPClient is
+// used.
+func WithMaxConnsPerHost(n int) Option {
+	return func(c *config) {
+		c.maxConnsPerHost = n
+	}
+}
+
+// WithInsecureSkipVerify disables TLS certificate verification, keeping the
+// retry configuration intact. Like WithProxy, it applies to the default
+// transport or an *http.Transport set with WithTransport, and is ignored when
+// WithHTTPClient is used.
+//
+// WARNING: this is for tests only, e.g. against a local mock server with a
+// self-signed certificate. It makes the client accept any certificate, so
+// anyone on the network path can intercept requests and read the API key.
+// Never use it in production.
+func WithInsecureSkipVerify() Option {
+	return func(c *config) {
+		c.insecure = true
+	}
+}
+
 // WithLogger sets a custom logger.
 func WithLogger(l Logger) Option {
 	return func(c *config) {
@@ -123,68 +356,197 @@ func WithLogger(l Logger) Option {
 	}
 }
 
+// WithDebugBodies enables logging of request and response bodies at debug
+// level. The API key and webhook auth headers are redacted, and bodies are
+// truncated to 4 KiB.
+func WithDebugBodies(enabled bool) Option {
+	return func(c *config) {
+		c.debugBodies = enabled
+	}
+}
+
+// WithRequestCompression gzips request bodies larger than 1 KiB, such as
+// large GetAssetBatch ID lists, and sends them with a Content-Encoding: gzip
+// header.
+//
+// Responses are compressed independently of this option: the default
+// transport requests gzip and decompresses responses transparently. A custom
+// transport set with WithTransport or WithHTTPClient must do the same to get
+// compressed responses.
+func WithRequestCompression() Option {
+	return func(c *config) {
+		c.compress = true
+	}
+}
+
+// WithResponseHeaderCallback registers fn to receive the headers of every
+// API response, including error responses, e.g. to track Helius's
+// x-ratelimit-* quota headers. path is the request path without the API key.
+//
+// fn is called synchronously from the goroutine making the request, so it
+// may run concurrently with itself when the client is shared, and should
+// return quickly. h must not be modified.
+func WithResponseHeaderCallback(fn func(path string, h http.Header)) Option {
+	return func(c *config) {
+		c.onHeaders = fn
+	}
+}
+
 // Client is the Helius API client.
 type Client struct {
-	apiKey     string
-	apiURL     string
-	rpcURL     string
-	httpClient *http.Client
-	logger     Logger
+	apiKey       string
+	apiURL       string
+	rpcURL       string
+	httpClient   *http.Client
+	logger       Logger
+	clock        clock
+	debugBodies  bool
+	onHeaders    func(path string, h http.Header)
+	compress     bool
+	strictJSON   bool
+	pageRetries  int
+	retryWaitMin time.Duration
+	strict       bool
+	cuBuffer     float64
+	minFee       float64
+	fanout       int
+	maxPages     int
+
+	// cfg is the configuration the client was built from, kept for Clone.
+	cfg config
 }
 
 // NewClient creates a new Helius API client.
 func NewClient(apiKey string, opts ...Option) (*Client, error) {
 	if apiKey == "" {
-		return nil, &APIError{
-			StatusCode: 400,
-			Message:    "API key is required",
-			Path:       "client",
-		}
+		return nil, ErrMissingAPIKey
 	}
 
 	cfg := &config{
 		network:      Mainnet,
 		timeout:      DefaultTimeout,
 		maxRetries:   DefaultMaxRetries,
+		pageRetries:  DefaultPageRetries,
+		fanout:       DefaultFanoutConcurrency,
 		retryWaitMin: DefaultRetryWaitMin,
 		retryWaitMax: DefaultRetryWaitMax,
 		logger:       noopLogger{},
+		clock:        realClock{},
 	}
 
 	for _, opt := range opts {
 		opt(cfg)
 	}
 
+	return newClient(apiKey, *cfg)
+}
+
+// Clone returns a new client with the same API key and configuration as c,
+// plus opts applied on top. c is not modified, so Clone suits request-scoped
+// settings such as a shorter timeout or a different logger:
+//
+//	fast, err := client.Clone(helius.WithTimeout(2 * time.Second))
+//
+// The clone gets its own HTTP client and connection pool, except when c was
+// created with WithHTTPClient and opts do not replace it; that client is then
+// shared as-is, and options it would otherwise carry, such as WithTimeout,
+// have no effect.
+func (c *Client) Clone(opts ...Option) (*Client, error) {
+	cfg := c.cfg
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return newClient(c.apiKey, cfg)
+}
+
+// newClient builds a client from a fully optioned configuration.
+func newClient(apiKey string, base config) (*Client, error) {
+	cfg := base
+
 	// Set URLs based on network if not explicitly provided
-	if cfg.apiURL == "" {
-		if cfg.network == Devnet {
-			cfg.apiURL = DefaultDevnetAPIURL
-		} else {
-			cfg.apiURL = DefaultMainnetAPIURL
+	urls, ok := lookupNetwork(cfg.network)
+	if !ok {
+		return nil, &ValidationError{
+			Field:   "network",
+			Message: fmt.Sprintf("unknown network %q", cfg.network),
 		}
 	}
+	if cfg.apiURL == "" {
+		cfg.apiURL = urls.apiURL
+	}
 	if cfg.rpcURL == "" {
-		if cfg.network == Devnet {
-			cfg.rpcURL = DefaultDevnetRPCURL
-		} else {
-			cfg.rpcURL = DefaultMainnetRPCURL
+		cfg.rpcURL = urls.rpcURL
+	}
+
+	if cfg.cuBuffer < 0 || math.IsNaN(cfg.cuBuffer) || math.IsInf(cfg.cuBuffer, 0) {
+		return nil, &ValidationError{
+			Field:   "computeUnitBuffer",
+			Message: fmt.Sprintf("compute unit buffer must be a non-negative fraction, got %v", cfg.cuBuffer),
 		}
 	}
 
+	if cfg.noRetries {
+		cfg.pageRetries = 0
+	}
+
+	if cfg.proxyURL != "" {
+		transport, err := proxyTransport(cfg.transport, cfg.proxyURL)
+		if err != nil {
+			return nil, err
+		}
+		cfg.transport = transport
+	}
+
+	if cfg.insecure {
+		transport, err := insecureTransport(cfg.transport)
+		if err != nil {
+			return nil, err
+		}
+		cfg.transport = transport
+	}
+
+	if cfg.maxIdleConnsPerHost > 0 || cfg.maxConnsPerHost > 0 {
+		transport, err := cloneTransport(cfg.transport, "transport", "connection limits")
+		if err != nil {
+			return nil, err
+		}
+		if cfg.maxIdleConnsPerHost > 0 {
+			transport.MaxIdleConnsPerHost = cfg.maxIdleConnsPerHost
+		}
+		if cfg.maxConnsPerHost > 0 {
+			transport.MaxConnsPerHost = cfg.maxConnsPerHost
+		}
+		cfg.transport = transport
+	}
+
 	var httpClient *http.Client
 	if cfg.httpClient != nil {
 		httpClient = cfg.httpClient
+	} else if cfg.noRetries {
+		httpClient = &http.Client{
+			Transport: cfg.transport,
+			Timeout:   cfg.timeout,
+		}
 	} else {
 		retryClient := retryablehttp.NewClient()
 		retryClient.RetryMax = cfg.maxRetries
 		retryClient.RetryWaitMin = cfg.retryWaitMin
 		retryClient.RetryWaitMax = cfg.retryWaitMax
 		retryClient.Logger = nil // Disable default logging
+		if cfg.transport != nil {
+			retryClient.HTTPClient.Transport = cfg.transport
+		}
 
+		// The context deadline is the overall budget for a call: backoff
+		// sleeps are interrupted when it expires, and no retry is attempted
+		// when too little time remains to wait for one.
 		retryClient.CheckRetry = func(ctx context.Context, resp *http.Response, err error) (bool, error) {
 			if ctx.Err() != nil {
 				return false, ctx.Err()
 			}
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < cfg.retryWaitMin {
+				return false, err
+			}
 			if err != nil {
 				return true, err
 			}
@@ -197,27 +559,237 @@ func NewClient(apiKey string, opts ...Option) (*Client, error) {
 			return false, nil
 		}
 
+		// Once retries are exhausted, hand back the last response, so that
+		// doRequest reports it as an *APIError (with its status code and
+		// Retry-After) rather than an opaque "giving up" error, or the last
+		// transport error unwrapped, so IsTransient can classify it.
+		retryClient.ErrorHandler = func(resp *http.Response, err error, _ int) (*http.Response, error) {
+			if err != nil {
+				if resp != nil {
+					_ = resp.Body.Close()
+				}
+				return nil, err
+			}
+			return resp, nil
+		}
+
 		httpClient = retryClient.StandardClient()
 		httpClient.Timeout = cfg.timeout
 	}
 
 	return &Client{
-		apiKey:     apiKey,
-		apiURL:     cfg.apiURL,
-		rpcURL:     cfg.rpcURL,
-		httpClient: httpClient,
-		logger:     cfg.logger,
+		apiKey:       apiKey,
+		apiURL:       cfg.apiURL,
+		rpcURL:       cfg.rpcURL,
+		httpClient:   httpClient,
+		logger:       cfg.logger,
+		clock:        cfg.clock,
+		debugBodies:  cfg.debugBodies,
+		onHeaders:    cfg.onHeaders,
+		compress:     cfg.compress,
+		strictJSON:   cfg.strictJSON,
+		pageRetries:  cfg.pageRetries,
+		retryWaitMin: cfg.retryWaitMin,
+		strict:       cfg.strict,
+		cuBuffer:     cfg.cuBuffer,
+		minFee:       cfg.minFee,
+		fanout:       cfg.fanout,
+		maxPages:     cfg.maxPages,
+		cfg:          base,
 	}, nil
 }
 
-// RPCURL returns the RPC URL with API key for use with solana-go.
+// proxyTransport returns a copy of base, or of http.DefaultTransport if base
+// is nil, that sends requests through proxyURL.
+func proxyTransport(base http.RoundTripper, proxyURL string) (*http.Transport, error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, &ValidationError{
+			Field:   "proxyURL",
+			Message: fmt.Sprintf("invalid proxy URL %q", proxyURL),
+		}
+	}
+
+	t, err := cloneTransport(base, "proxyURL", "a proxy")
+	if err != nil {
+		return nil, err
+	}
+	t.Proxy = http.ProxyURL(u)
+	return t, nil
+}
+
+// insecureTransport returns a copy of base, or of http.DefaultTransport if
+// base is nil, that skips TLS certificate verification. It still requires at
+// least TLS 1.2 unless base sets its own minimum.
+func insecureTransport(base http.RoundTripper) (*http.Transport, error) {
+	t, err := cloneTransport(base, "insecureSkipVerify", "InsecureSkipVerify")
+	if err != nil {
+		return nil, err
+	}
+	if t.TLSClientConfig == nil {
+		t.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	if t.TLSClientConfig.MinVersion == 0 {
+		t.TLSClientConfig.MinVersion = tls.VersionTLS12
+	}
+	t.TLSClientConfig.InsecureSkipVerify = true //nolint:gosec // Only set by the opt-in WithInsecureSkipVerify.
+	return t, nil
+}
+
+// cloneTransport returns a copy of base, or of http.DefaultTransport if base
+// is nil. base must be an *http.Transport; otherwise the *ValidationError
+// names field and the setting that could not be applied.
+func cloneTransport(base http.RoundTripper, field, setting string) (*http.Transport, error) {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	t, ok := base.(*http.Transport)
+	if !ok {
+		return nil, &ValidationError{
+			Field:   field,
+			Message: fmt.Sprintf("cannot set %s on transport of type %T", setting, base),
+		}
+	}
+	return t.Clone(), nil
+}
+
+// pingTimeout bounds the request made by Ping.
+const pingTimeout = 5 * time.Second
+
+// Ping verifies connectivity and that the API key is accepted by making a
+// lightweight authenticated request.
+//
+// It returns nil on success. If the key is rejected (401 or 403), the error
+// matches ErrInvalidAPIKey with errors.Is and still wraps the *APIError.
+func (c *Client) Ping(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+
+	_, err := c.doGet(ctx, "/webhooks")
+	if err != nil {
+		if apiErr, ok := IsAPIError(err); ok && (apiErr.IsUnauthorized() || apiErr.IsForbidden()) {
+			return fmt.Errorf("%w: %w", ErrInvalidAPIKey, err)
+		}
+		return fmt.Errorf("ping: %w", err)
+	}
+	return nil
+}
+
+// apiKeyContextKey is the context key for per-request API key overrides.
+type apiKeyContextKey struct{}
+
+// WithAPIKeyContext returns a copy of ctx that makes requests use apiKey
+// instead of the client's API key.
+//
+// This lets a single Client serve multiple tenants, each with their own key.
+//
+// Example:
+//
+//	ctx = helius.WithAPIKeyContext(ctx, customer.HeliusKey)
+//	assets, err := client.GetAssetsByOwner(ctx, customer.Wallet, nil)
+func WithAPIKeyContext(ctx context.Context, apiKey string) context.Context {
+	return context.WithValue(ctx, apiKeyContextKey{}, apiKey)
+}
+
+// apiKeyFor returns the API key override in ctx, or the client's API key.
+func (c *Client) apiKeyFor(ctx context.Context) string {
+	if key, ok := ctx.Value(apiKeyContextKey{}).(string); ok && key != "" {
+		return key
+	}
+	return c.apiKey
+}
+
+// idempotencyKeyContextKey is the context key for write idempotency keys.
+type idempotencyKeyContextKey struct{}
+
+// IdempotencyKeyHeader is the header that carries the key set by
+// WithIdempotencyKey.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// WithIdempotencyKey returns a copy of ctx that makes POST and PUT requests,
+// such as CreateWebhook and MintCompressedNFT, carry key in the
+// Idempotency-Key header. The header is sent unchanged on every retry of
+// the request, and is not sent on GET or DELETE requests.
+//
+// This only prevents duplicate writes if the server honors the header, and
+// Helius does not document doing so for every endpoint. Use a fresh key per
+// logical operation, and reuse it only when repeating that operation.
+//
+// Example:
+//
+//	ctx = helius.WithIdempotencyKey(ctx, uuid.NewString())
+//	webhook, err := client.CreateWebhook(ctx, req)
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// idempotencyKeyFor returns the idempotency key in ctx, if any.
+func idempotencyKeyFor(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKeyContextKey{}).(string)
+	return key
+}
+
+// HTTPClient returns the *http.Client the client sends requests with: the
+// one passed to WithHTTPClient, or the retrying client built by NewClient,
+// whose Transport performs the retries.
+//
+// It is meant for inspection and for tuning before the client is first used.
+// Modifying it, or its transport, while requests are in flight is not safe,
+// and changes are shared with any client it was passed to via WithHTTPClient.
+func (c *Client) HTTPClient() *http.Client {
+	return c.httpClient
+}
+
+// RPCURL returns the RPC URL with API key for use with solana-go:
+//
+//	solanaClient := rpc.New(client.RPCURL())
+//
+// The solana subpackage, a separate module so that this one does not depend
+// on solana-go, provides this as solana.RPCClient.
 func (c *Client) RPCURL() string {
 	return fmt.Sprintf("%s/?api-key=%s", c.rpcURL, c.apiKey)
 }
 
+// WebSocketURL returns the RPC WebSocket URL with API key, for account and
+// log subscriptions with solana-go's ws client. It is RPCURL with the https
+// scheme replaced by wss, or http by ws for a custom plain-HTTP RPC URL.
+func (c *Client) WebSocketURL() string {
+	rpcURL := c.rpcURL
+	switch {
+	case strings.HasPrefix(rpcURL, "https://"):
+		rpcURL = "wss://" + strings.TrimPrefix(rpcURL, "https://")
+	case strings.HasPrefix(rpcURL, "http://"):
+		rpcURL = "ws://" + strings.TrimPrefix(rpcURL, "http://")
+	}
+	return fmt.Sprintf("%s/?api-key=%s", rpcURL, c.apiKey)
+}
+
 // doRequest performs an HTTP request and returns the response body.
 func (c *Client) doRequest(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
-	url := fmt.Sprintf("%s%s?api-key=%s", c.apiURL, path, c.apiKey)
+	apiKey := c.apiKeyFor(ctx)
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	url := fmt.Sprintf("%s%s%sapi-key=%s", c.apiURL, path, sep, apiKey)
+
+	var gzipped bool
+	if (c.debugBodies || c.compress) && body != nil {
+		reqBody, err := io.ReadAll(body)
+		if err != nil {
+			return nil, fmt.Errorf("read request body: %w", err)
+		}
+		if c.debugBodies {
+			c.logger.Debug("request body", "method", method, "url", c.redact(url, apiKey), "body", c.redactBody(reqBody, apiKey))
+		}
+		if c.compress && len(reqBody) > compressThreshold {
+			if reqBody, err = gzipBytes(reqBody); err != nil {
+				return nil, fmt.Errorf("compress request body: %w", err)
+			}
+			gzipped = true
+		}
+		body = bytes.NewReader(reqBody)
+	}
 
 	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
@@ -227,32 +799,95 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body io.Rea
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
+	if gzipped {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+	if method == http.MethodPost || method == http.MethodPut {
+		if key := idempotencyKeyFor(ctx); key != "" {
+			req.Header.Set(IdempotencyKeyHeader, key)
+		}
+	}
 
 	c.logger.Debug("making request", "method", method, "path", path)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("do request: %w", err)
+		return nil, fmt.Errorf("do request: %w", newRedactedError(err))
 	}
 	defer func() { _ = resp.Body.Close() }()
 
+	if c.onHeaders != nil {
+		c.onHeaders(path, resp.Header)
+	}
+
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("read response: %w", err)
 	}
 
+	if c.debugBodies {
+		c.logger.Debug("response body", "status", resp.StatusCode, "path", path, "body", c.redactBody(respBody, apiKey))
+	}
+
 	if resp.StatusCode >= 400 {
-		c.logger.Error("api error", "status", resp.StatusCode, "path", path, "body", string(respBody))
+		c.logger.Error("api error", "status", resp.StatusCode, "path", path, "body", c.redactBody(respBody, apiKey))
 		return nil, &APIError{
 			StatusCode: resp.StatusCode,
 			Message:    string(respBody),
 			Path:       path,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), c.clock.Now()),
 		}
 	}
 
 	return respBody, nil
 }
 
+// compressThreshold is the request body size above which
+// WithRequestCompression gzips the body.
+const compressThreshold = 1024
+
+// gzipBytes returns b compressed with gzip.
+func gzipBytes(b []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(b); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// maxLoggedBodySize caps the number of body bytes written to debug logs.
+const maxLoggedBodySize = 4 << 10 // 4 KiB
+
+// redactedValue replaces secrets in logged output.
+const redactedValue = "REDACTED"
+
+// authHeaderPattern matches webhook authHeader fields in JSON bodies.
+var authHeaderPattern = regexp.MustCompile(`("authHeader"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// redact replaces every occurrence of the client's API key and the
+// request's apiKey in s.
+func (c *Client) redact(s, apiKey string) string {
+	s = strings.ReplaceAll(s, c.apiKey, redactedValue)
+	if apiKey != "" && apiKey != c.apiKey {
+		s = strings.ReplaceAll(s, apiKey, redactedValue)
+	}
+	return s
+}
+
+// redactBody returns body as a string suitable for logging: secrets are
+// redacted and the result is truncated to maxLoggedBodySize.
+func (c *Client) redactBody(body []byte, apiKey string) string {
+	s := authHeaderPattern.ReplaceAllString(c.redact(string(body), apiKey), `$1"`+redactedValue+`"`)
+	if len(s) > maxLoggedBodySize {
+		s = s[:maxLoggedBodySize] + "...(truncated)"
+	}
+	return s
+}
+
 // doGet performs an HTTP GET request.
 func (c *Client) doGet(ctx context.Context, path string) ([]byte, error) {
 	return c.doRequest(ctx, http.MethodGet, path, nil)
@@ -267,6 +902,58 @@ func (c *Client) doPost(ctx context.Context, path string, body interface{}) ([]b
 	return c.doRequest(ctx, http.MethodPost, path, jsonReaderFrom(jsonBody))
 }
 
+// doRPCPost is doPost for RPC-style endpoints, which report failures as a
+// JSON-RPC error object in a 200 response; such a response is returned as an
+// *APIError with RPCCode set.
+func (c *Client) doRPCPost(ctx context.Context, path string, body interface{}) ([]byte, error) {
+	respBody, err := c.doPost(ctx, path, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := rpcErrorFromBody(path, http.StatusOK, respBody); err != nil {
+		c.logger.Error("api error", "status", http.StatusOK, "path", path, "body", c.redactBody(respBody, c.apiKeyFor(ctx)))
+		return nil, err
+	}
+	return respBody, nil
+}
+
+// RawCall is an escape hatch for endpoints and fields this package does not
+// cover yet. It sends a request to path (relative to the API URL, e.g.
+// "/addresses/{address}/balances") with body encoded as JSON, unless body is
+// nil, and decodes the JSON response into out, unless out is nil.
+//
+// Authentication, retries, and logging behave as for any other method, and
+// 4xx/5xx responses are returned as *APIError.
+//
+// Example:
+//
+//	var balances struct {
+//	    NativeBalance int64 `json:"nativeBalance"`
+//	}
+//	err := client.RawCall(ctx, http.MethodGet, "/addresses/"+addr+"/balances", nil, &balances)
+func (c *Client) RawCall(ctx context.Context, method, path string, body interface{}, out interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		jsonBody, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("marshal request: %w", err)
+		}
+		reqBody = jsonReaderFrom(jsonBody)
+	}
+
+	respBody, err := c.doRequest(ctx, method, path, reqBody)
+	if err != nil {
+		return err
+	}
+
+	if out != nil {
+		if err := c.decode(respBody, out); err != nil {
+			return fmt.Errorf("decode response: %w", err)
+		}
+	}
+	return nil
+}
+
 // jsonReaderFrom creates a reader from JSON bytes.
 func jsonReaderFrom(data []byte) io.Reader {
 	return &jsonReader{data: data}
@@ -285,3 +972,44 @@ func (r *jsonReader) Read(p []byte) (n int, err error) {
 	r.pos += n
 	return n, nil
 }
+
+// isTransient reports whether a failed page is worth retrying. It is
+// IsTransient, except that unclassified errors, such as those from a custom
+// HTTP client passed with WithHTTPClient, are also assumed to be transient.
+func isTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+	if IsTransient(err) {
+		return true
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if _, ok := IsValidationError(err); ok {
+		return false
+	}
+	if _, ok := IsAPIError(err); ok {
+		return false
+	}
+	return true
+}
+
+// retryPage calls fetch, retrying transient failures up to c.pageRetries
+// times with exponential backoff starting at c.retryWaitMin.
+func retryPage[T any](ctx context.Context, c *Client, fetch func() (T, error)) (T, error) {
+	for attempt := 0; ; attempt++ {
+		result, err := fetch()
+		if err == nil || attempt >= c.pageRetries || !isTransient(err) {
+			return result, err
+		}
+
+		wait := c.retryWaitMin << attempt
+		c.logger.Warn("retrying page", "attempt", attempt+1, "wait", wait, "error", err)
+
+		if err := c.clock.Sleep(ctx, wait); err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+}
@@ -1,14 +1,21 @@
 package helius
 
 import (
+	"compress/gzip"
 	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
 )
 
 func TestNewClient(t *testing.T) {
@@ -31,12 +38,11 @@ func TestNewClient(t *testing.T) {
 		if err == nil {
 			t.Error("NewClient should return error for empty API key")
 		}
-		apiErr, ok := IsAPIError(err)
-		if !ok {
-			t.Error("error should be APIError")
+		if !errors.Is(err, ErrMissingAPIKey) {
+			t.Errorf("error = %v, want ErrMissingAPIKey", err)
 		}
-		if apiErr.StatusCode != 400 {
-			t.Errorf("StatusCode = %d, want 400", apiErr.StatusCode)
+		if _, ok := IsAPIError(err); ok {
+			t.Error("error should not be APIError")
 		}
 	})
 }
@@ -69,6 +75,148 @@ func TestNewClient_WithNetwork(t *testing.T) {
 	})
 }
 
+func TestRegisterNetwork(t *testing.T) {
+	stagingAPI := "https://api.staging.example.com/v0"
+	stagingRPC := "https://rpc.staging.example.com"
+	RegisterNetwork("staging", stagingAPI, stagingRPC)
+
+	t.Run("registered network", func(t *testing.T) {
+		client, err := NewClient("test-api-key", WithNetwork("staging"))
+		if err != nil {
+			t.Fatalf("NewClient returned error: %v", err)
+		}
+		if client.apiURL != stagingAPI {
+			t.Errorf("apiURL = %s, want %s", client.apiURL, stagingAPI)
+		}
+		if client.rpcURL != stagingRPC {
+			t.Errorf("rpcURL = %s, want %s", client.rpcURL, stagingRPC)
+		}
+	})
+
+	t.Run("explicit url overrides network", func(t *testing.T) {
+		client, err := NewClient("test-api-key",
+			WithNetwork("staging"),
+			WithAPIURL("https://override.example.com"),
+		)
+		if err != nil {
+			t.Fatalf("NewClient returned error: %v", err)
+		}
+		if client.apiURL != "https://override.example.com" {
+			t.Errorf("apiURL = %s, want override", client.apiURL)
+		}
+		if client.rpcURL != stagingRPC {
+			t.Errorf("rpcURL = %s, want %s", client.rpcURL, stagingRPC)
+		}
+	})
+
+	t.Run("unregistered network", func(t *testing.T) {
+		_, err := NewClient("test-api-key", WithNetwork("no-such-network"))
+		if err == nil {
+			t.Error("NewClient should return error for unregistered network")
+		}
+		if _, ok := IsValidationError(err); !ok {
+			t.Errorf("error should be ValidationError, got %T", err)
+		}
+	})
+}
+
+func TestNewClient_WithStrictJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		response string
+		call     func(*Client) error
+		wantErr  string
+	}{
+		{
+			name:     "unknown top-level field",
+			response: `{"id":"asset-1","interface":"V1_NFT","newField":true}`,
+			call: func(c *Client) error {
+				_, err := c.GetAsset(context.Background(), "asset-1")
+				return err
+			},
+			wantErr: `unknown field "newField"`,
+		},
+		{
+			name:     "unknown nested field",
+			response: `{"id":"asset-1","ownership":{"owner":"o","frozen":false,"extra":1}}`,
+			call: func(c *Client) error {
+				_, err := c.GetAsset(context.Background(), "asset-1")
+				return err
+			},
+			wantErr: `unknown field "ownership.extra"`,
+		},
+		{
+			name:     "unknown field in page item",
+			response: `{"total":1,"limit":10,"page":1,"grandTotal":1,"items":[{"id":"asset-1","extra":1}]}`,
+			call: func(c *Client) error {
+				_, err := c.GetAssetsByOwner(context.Background(), "86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY", nil)
+				return err
+			},
+			wantErr: `unknown field "items[0].extra"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			lenient, _ := NewClient("test-key", WithAPIURL(server.URL))
+			if err := tt.call(lenient); err != nil {
+				t.Fatalf("without strict JSON: unexpected error: %v", err)
+			}
+
+			strict, _ := NewClient("test-key", WithAPIURL(server.URL), WithStrictJSON())
+			err := tt.call(strict)
+			if err == nil {
+				t.Fatal("with strict JSON: expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %q, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("accepts known fields", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"total":1,"limit":10,"page":1,"grandTotal":1,"items":[{"id":"asset-1","content":{"metadata":{"anything":"goes"}}}]}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL), WithStrictJSON())
+		if _, err := client.GetAssetsByOwner(context.Background(), "86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY", nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestResolveURLs(t *testing.T) {
+	RegisterNetwork("resolve-test", "https://api.resolve.example.com/v0", "https://rpc.resolve.example.com")
+
+	tests := []struct {
+		network Network
+		apiURL  string
+		rpcURL  string
+	}{
+		{Mainnet, DefaultMainnetAPIURL, DefaultMainnetRPCURL},
+		{Devnet, DefaultDevnetAPIURL, DefaultDevnetRPCURL},
+		{"resolve-test", "https://api.resolve.example.com/v0", "https://rpc.resolve.example.com"},
+		{"no-such-network", DefaultMainnetAPIURL, DefaultMainnetRPCURL},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.network), func(t *testing.T) {
+			apiURL, rpcURL := ResolveURLs(tt.network)
+			if apiURL != tt.apiURL || rpcURL != tt.rpcURL {
+				t.Errorf("ResolveURLs(%s) = %s, %s, want %s, %s", tt.network, apiURL, rpcURL, tt.apiURL, tt.rpcURL)
+			}
+		})
+	}
+}
+
 func TestNewClient_WithCustomURLs(t *testing.T) {
 	customAPI := "https://custom-api.example.com"
 	customRPC := "https://custom-rpc.example.com"
@@ -120,6 +268,321 @@ func TestNewClient_WithHTTPClient(t *testing.T) {
 	}
 }
 
+// roundTripFunc adapts a function to http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestNewClient_WithTransport(t *testing.T) {
+	var calls int
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		if calls == 1 {
+			return &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     http.Header{"Retry-After": []string{"0"}},
+				Body:       io.NopCloser(strings.NewReader("rate limited")),
+				Request:    req,
+			}, nil
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"id":"asset-1","interface":"V1_NFT"}`)),
+			Request:    req,
+		}, nil
+	})
+
+	client, err := NewClient("test-key", WithTransport(transport))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	asset, err := client.GetAsset(context.Background(), "asset-1")
+	if err != nil {
+		t.Fatalf("GetAsset returned error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2 (one retry after 429)", calls)
+	}
+	if asset.ID != "asset-1" {
+		t.Errorf("ID = %s, want asset-1", asset.ID)
+	}
+}
+
+func TestNewClient_WithProxy(t *testing.T) {
+	t.Run("routes requests through the proxy", func(t *testing.T) {
+		var proxied string
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			proxied = r.URL.Host
+			w.Write([]byte(`{"id":"asset-1"}`))
+		}))
+		defer proxy.Close()
+
+		client, err := NewClient("test-key", WithAPIURL("http://helius.invalid"), WithProxy(proxy.URL))
+		if err != nil {
+			t.Fatalf("NewClient returned error: %v", err)
+		}
+
+		asset, err := client.GetAsset(context.Background(), "asset-1")
+		if err != nil {
+			t.Fatalf("GetAsset returned error: %v", err)
+		}
+		if asset.ID != "asset-1" {
+			t.Errorf("ID = %s, want asset-1", asset.ID)
+		}
+		if proxied != "helius.invalid" {
+			t.Errorf("proxied host = %q, want helius.invalid", proxied)
+		}
+	})
+
+	t.Run("sets proxy on a custom transport", func(t *testing.T) {
+		base := &http.Transport{MaxIdleConns: 7}
+		transport, err := proxyTransport(base, "http://proxy.internal:3128")
+		if err != nil {
+			t.Fatalf("proxyTransport returned error: %v", err)
+		}
+		if transport.MaxIdleConns != 7 {
+			t.Errorf("MaxIdleConns = %d, want 7", transport.MaxIdleConns)
+		}
+		if base.Proxy != nil {
+			t.Error("base transport should not be modified")
+		}
+
+		req, _ := http.NewRequest("GET", "https://api.helius.xyz/v0/assets", nil)
+		u, err := transport.Proxy(req)
+		if err != nil || u.String() != "http://proxy.internal:3128" {
+			t.Errorf("Proxy(req) = %v, %v, want http://proxy.internal:3128", u, err)
+		}
+	})
+
+	t.Run("invalid proxy URL", func(t *testing.T) {
+		for _, proxyURL := range []string{"://bad", "proxy.internal:3128"} {
+			_, err := NewClient("test-key", WithProxy(proxyURL))
+			if valErr, ok := IsValidationError(err); !ok || valErr.Field != "proxyURL" {
+				t.Errorf("NewClient(WithProxy(%q)) err = %v, want ValidationError for proxyURL", proxyURL, err)
+			}
+		}
+	})
+
+	t.Run("non-http transport", func(t *testing.T) {
+		transport := roundTripFunc(func(req *http.Request) (*http.Response, error) { return nil, nil })
+		_, err := NewClient("test-key", WithTransport(transport), WithProxy("http://proxy.internal:3128"))
+		if _, ok := IsValidationError(err); !ok {
+			t.Errorf("err = %v, want ValidationError", err)
+		}
+	})
+}
+
+func TestNewClient_WithInsecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"asset-1"}`))
+	}))
+	defer server.Close()
+
+	t.Run("accepts self-signed certificate", func(t *testing.T) {
+		client, err := NewClient("test-key", WithAPIURL(server.URL), WithInsecureSkipVerify())
+		if err != nil {
+			t.Fatalf("NewClient returned error: %v", err)
+		}
+
+		asset, err := client.GetAsset(context.Background(), "asset-1")
+		if err != nil {
+			t.Fatalf("GetAsset returned error: %v", err)
+		}
+		if asset.ID != "asset-1" {
+			t.Errorf("ID = %s, want asset-1", asset.ID)
+		}
+	})
+
+	t.Run("rejects self-signed certificate by default", func(t *testing.T) {
+		client, _ := NewClient("test-key", WithAPIURL(server.URL), WithMaxRetries(0))
+		if _, err := client.GetAsset(context.Background(), "asset-1"); err == nil {
+			t.Error("expected certificate error without WithInsecureSkipVerify")
+		}
+	})
+
+	t.Run("keeps custom transport settings", func(t *testing.T) {
+		base := &http.Transport{MaxIdleConns: 7}
+		transport, err := insecureTransport(base)
+		if err != nil {
+			t.Fatalf("insecureTransport returned error: %v", err)
+		}
+		if transport.MaxIdleConns != 7 || !transport.TLSClientConfig.InsecureSkipVerify {
+			t.Errorf("transport = %+v, want MaxIdleConns 7 and InsecureSkipVerify", transport)
+		}
+		if transport.TLSClientConfig.MinVersion != tls.VersionTLS12 {
+			t.Errorf("MinVersion = %#x, want TLS 1.2", transport.TLSClientConfig.MinVersion)
+		}
+		if base.TLSClientConfig != nil && base.TLSClientConfig.InsecureSkipVerify {
+			t.Error("base transport should not be modified")
+		}
+	})
+}
+
+func TestNewClient_ConnectionPool(t *testing.T) {
+	retryTransport := func(t *testing.T, c *Client) *http.Transport {
+		t.Helper()
+		rt, ok := c.httpClient.Transport.(*retryablehttp.RoundTripper)
+		if !ok {
+			t.Fatalf("Transport = %T, want *retryablehttp.RoundTripper", c.httpClient.Transport)
+		}
+		transport, ok := rt.Client.HTTPClient.Transport.(*http.Transport)
+		if !ok {
+			t.Fatalf("retry client transport = %T, want *http.Transport", rt.Client.HTTPClient.Transport)
+		}
+		return transport
+	}
+
+	t.Run("sets limits on the retry transport", func(t *testing.T) {
+		client, err := NewClient("test-key", WithMaxIdleConnsPerHost(64), WithMaxConnsPerHost(128))
+		if err != nil {
+			t.Fatalf("NewClient returned error: %v", err)
+		}
+		transport := retryTransport(t, client)
+		if transport.MaxIdleConnsPerHost != 64 {
+			t.Errorf("MaxIdleConnsPerHost = %d, want 64", transport.MaxIdleConnsPerHost)
+		}
+		if transport.MaxConnsPerHost != 128 {
+			t.Errorf("MaxConnsPerHost = %d, want 128", transport.MaxConnsPerHost)
+		}
+	})
+
+	t.Run("keeps custom transport settings", func(t *testing.T) {
+		base := &http.Transport{MaxIdleConns: 7}
+		client, err := NewClient("test-key", WithTransport(base), WithMaxIdleConnsPerHost(16))
+		if err != nil {
+			t.Fatalf("NewClient returned error: %v", err)
+		}
+		transport := retryTransport(t, client)
+		if transport.MaxIdleConns != 7 || transport.MaxIdleConnsPerHost != 16 {
+			t.Errorf("MaxIdleConns/MaxIdleConnsPerHost = %d/%d, want 7/16", transport.MaxIdleConns, transport.MaxIdleConnsPerHost)
+		}
+		if base.MaxIdleConnsPerHost != 0 {
+			t.Error("base transport should not be modified")
+		}
+	})
+
+	t.Run("non-http transport", func(t *testing.T) {
+		transport := roundTripFunc(func(req *http.Request) (*http.Response, error) { return nil, nil })
+		_, err := NewClient("test-key", WithTransport(transport), WithMaxConnsPerHost(8))
+		if _, ok := IsValidationError(err); !ok {
+			t.Errorf("err = %v, want ValidationError", err)
+		}
+	})
+}
+
+func TestNewClient_WithoutRetries(t *testing.T) {
+	t.Run("server error returned without retrying", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte("boom"))
+		}))
+		defer server.Close()
+
+		client, err := NewClient("test-key", WithAPIURL(server.URL), WithoutRetries(), WithTimeout(7*time.Second))
+		if err != nil {
+			t.Fatalf("NewClient returned error: %v", err)
+		}
+		if client.httpClient.Timeout != 7*time.Second {
+			t.Errorf("Timeout = %v, want 7s", client.httpClient.Timeout)
+		}
+		if client.pageRetries != 0 {
+			t.Errorf("pageRetries = %d, want 0", client.pageRetries)
+		}
+
+		_, err = client.GetAsset(context.Background(), "asset-1")
+		apiErr, ok := IsAPIError(err)
+		if !ok {
+			t.Fatalf("err = %v (%T), want *APIError", err, err)
+		}
+		if apiErr.StatusCode != http.StatusInternalServerError {
+			t.Errorf("StatusCode = %d, want 500", apiErr.StatusCode)
+		}
+		if got := atomic.LoadInt32(&calls); got != 1 {
+			t.Errorf("calls = %d, want 1", got)
+		}
+	})
+
+	t.Run("cancellation is a plain context error", func(t *testing.T) {
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+		defer server.Close()
+		defer close(release)
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL), WithoutRetries())
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			cancel()
+		}()
+
+		_, err := client.GetAsset(ctx, "asset-1")
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("err = %v, want context.Canceled", err)
+		}
+		if strings.Contains(err.Error(), "giving up") {
+			t.Errorf("err = %v, should not be a retry error", err)
+		}
+	})
+}
+
+func TestClient_Clone(t *testing.T) {
+	logger := &mockLogger{}
+	original, err := NewClient("test-key",
+		WithNetwork(Devnet),
+		WithTimeout(30*time.Second),
+		WithLogger(logger),
+		WithStrictValidation(true),
+	)
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	cloneLogger := &mockLogger{}
+	clone, err := original.Clone(WithTimeout(2*time.Second), WithLogger(cloneLogger))
+	if err != nil {
+		t.Fatalf("Clone returned error: %v", err)
+	}
+
+	if clone.httpClient.Timeout != 2*time.Second {
+		t.Errorf("clone Timeout = %v, want 2s", clone.httpClient.Timeout)
+	}
+	if original.httpClient.Timeout != 30*time.Second {
+		t.Errorf("original Timeout = %v, want 30s", original.httpClient.Timeout)
+	}
+	if clone.httpClient == original.httpClient {
+		t.Error("clone should not share the original's http.Client")
+	}
+	if clone.logger != cloneLogger || original.logger != logger {
+		t.Error("loggers should be independent")
+	}
+
+	// Settings not overridden carry over.
+	if clone.apiKey != "test-key" || clone.apiURL != original.apiURL || !clone.strict {
+		t.Errorf("clone = %+v, want original settings carried over", clone)
+	}
+
+	// Network-derived URLs are recomputed.
+	mainnet, err := original.Clone(WithNetwork(Mainnet))
+	if err != nil {
+		t.Fatalf("Clone returned error: %v", err)
+	}
+	if mainnet.apiURL == original.apiURL {
+		t.Errorf("apiURL = %s, want mainnet URL", mainnet.apiURL)
+	}
+
+	if _, err := original.Clone(WithProxy("::bad")); err == nil {
+		t.Error("Clone should return option validation errors")
+	}
+}
+
 // mockLogger implements Logger for testing
 type mockLogger struct {
 	debugCalls int
@@ -133,6 +596,206 @@ func (m *mockLogger) Info(msg string, keysAndValues ...interface{})  { m.infoCal
 func (m *mockLogger) Warn(msg string, keysAndValues ...interface{})  { m.warnCalls++ }
 func (m *mockLogger) Error(msg string, keysAndValues ...interface{}) { m.errorCalls++ }
 
+// recordingLogger implements Logger and keeps every formatted entry.
+type recordingLogger struct {
+	entries []string
+}
+
+func (r *recordingLogger) record(msg string, keysAndValues []interface{}) {
+	r.entries = append(r.entries, fmt.Sprint(append([]interface{}{msg}, keysAndValues...)...))
+}
+
+func (r *recordingLogger) Debug(msg string, kv ...interface{}) { r.record(msg, kv) }
+func (r *recordingLogger) Info(msg string, kv ...interface{})  { r.record(msg, kv) }
+func (r *recordingLogger) Warn(msg string, kv ...interface{})  { r.record(msg, kv) }
+func (r *recordingLogger) Error(msg string, kv ...interface{}) { r.record(msg, kv) }
+
+func TestNewClient_WithDebugBodies(t *testing.T) {
+	const apiKey = "secret-api-key-123"
+	const authHeader = "Bearer top-secret-token"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), authHeader) {
+			t.Error("request body sent to server should not be redacted")
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Webhook{
+			WebhookID:  "webhook-123",
+			WebhookURL: "https://example.com/webhook",
+			AuthHeader: authHeader,
+		})
+	}))
+	defer server.Close()
+
+	logger := &recordingLogger{}
+	client, _ := NewClient(apiKey,
+		WithAPIURL(server.URL),
+		WithLogger(logger),
+		WithDebugBodies(true),
+	)
+
+	_, err := client.CreateWebhook(context.Background(), &CreateWebhookRequest{
+		WebhookURL:       "https://example.com/webhook",
+		TransactionTypes: []TransactionType{TransactionTypeSwap},
+		AccountAddresses: []string{"address1"},
+		AuthHeader:       authHeader,
+	})
+	if err != nil {
+		t.Fatalf("CreateWebhook returned error: %v", err)
+	}
+
+	var sawRequest, sawResponse bool
+	for _, entry := range logger.entries {
+		if strings.Contains(entry, apiKey) {
+			t.Errorf("log entry contains api key: %s", entry)
+		}
+		if strings.Contains(entry, "top-secret-token") {
+			t.Errorf("log entry contains auth header: %s", entry)
+		}
+		if strings.HasPrefix(entry, "request body") {
+			sawRequest = true
+		}
+		if strings.HasPrefix(entry, "response body") {
+			sawResponse = true
+		}
+	}
+	if !sawRequest {
+		t.Error("request body should be logged")
+	}
+	if !sawResponse {
+		t.Error("response body should be logged")
+	}
+}
+
+func TestNewClient_WithResponseHeaderCallback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Ratelimit-Remaining", "42")
+		w.Header().Set("X-Ratelimit-Limit", "50")
+		if r.URL.Path == "/webhooks/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"id":"asset-1"}`))
+	}))
+	defer server.Close()
+
+	var paths []string
+	var remaining []string
+	client, _ := NewClient("test-key",
+		WithAPIURL(server.URL),
+		WithResponseHeaderCallback(func(path string, h http.Header) {
+			paths = append(paths, path)
+			remaining = append(remaining, h.Get("X-Ratelimit-Remaining"))
+		}),
+	)
+
+	if _, err := client.GetAsset(context.Background(), "asset-1"); err != nil {
+		t.Fatalf("GetAsset returned error: %v", err)
+	}
+	if _, err := client.GetWebhook(context.Background(), "missing"); err == nil {
+		t.Fatal("expected error for missing webhook")
+	}
+
+	if want := []string{"/assets", "/webhooks/missing"}; strings.Join(paths, ",") != strings.Join(want, ",") {
+		t.Errorf("paths = %v, want %v", paths, want)
+	}
+	if want := []string{"42", "42"}; strings.Join(remaining, ",") != strings.Join(want, ",") {
+		t.Errorf("X-Ratelimit-Remaining = %v, want %v", remaining, want)
+	}
+}
+
+func TestNewClient_WithRequestCompression(t *testing.T) {
+	t.Run("gzips large request bodies", func(t *testing.T) {
+		ids := make([]string, 100)
+		for i := range ids {
+			ids[i] = fmt.Sprintf("asset-%03d-JDuAmJgJ7fmUJhtsiTUvdqYzznuEygrkwQZGbsoTCs6w", i)
+		}
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Content-Encoding") != "gzip" {
+				t.Errorf("Content-Encoding = %q, want gzip", r.Header.Get("Content-Encoding"))
+			}
+			zr, err := gzip.NewReader(r.Body)
+			if err != nil {
+				t.Fatalf("gzip.NewReader: %v", err)
+			}
+			var req struct {
+				IDs []string `json:"ids"`
+			}
+			if err := json.NewDecoder(zr).Decode(&req); err != nil {
+				t.Fatalf("decode gzipped body: %v", err)
+			}
+			if len(req.IDs) != len(ids) {
+				t.Errorf("len(ids) = %d, want %d", len(req.IDs), len(ids))
+			}
+			w.Write([]byte(`[]`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL), WithRequestCompression())
+		if _, err := client.GetAssetBatch(context.Background(), ids); err != nil {
+			t.Fatalf("GetAssetBatch returned error: %v", err)
+		}
+	})
+
+	t.Run("leaves small request bodies alone", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if enc := r.Header.Get("Content-Encoding"); enc != "" {
+				t.Errorf("Content-Encoding = %q, want none", enc)
+			}
+			w.Write([]byte(`{"id":"asset-1"}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL), WithRequestCompression())
+		if _, err := client.GetAsset(context.Background(), "asset-1"); err != nil {
+			t.Fatalf("GetAsset returned error: %v", err)
+		}
+	})
+
+	t.Run("decodes gzip responses", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+				t.Errorf("Accept-Encoding = %q, want gzip", r.Header.Get("Accept-Encoding"))
+			}
+			w.Header().Set("Content-Encoding", "gzip")
+			zw := gzip.NewWriter(w)
+			zw.Write([]byte(`{"id":"asset-1","interface":"V1_NFT"}`))
+			zw.Close()
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		asset, err := client.GetAsset(context.Background(), "asset-1")
+		if err != nil {
+			t.Fatalf("GetAsset returned error: %v", err)
+		}
+		if asset.ID != "asset-1" || asset.Interface != "V1_NFT" {
+			t.Errorf("asset = %+v, want asset-1 V1_NFT", asset)
+		}
+	})
+}
+
+func TestClient_redactBody(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	t.Run("truncates large bodies", func(t *testing.T) {
+		got := client.redactBody([]byte(strings.Repeat("a", maxLoggedBodySize*2)), "")
+		if len(got) > maxLoggedBodySize+len("...(truncated)") {
+			t.Errorf("len(body) = %d, want at most %d", len(got), maxLoggedBodySize+len("...(truncated)"))
+		}
+	})
+
+	t.Run("redacts auth header", func(t *testing.T) {
+		got := client.redactBody([]byte(`{"authHeader": "Bearer \"quoted\" secret","webhookURL":"https://example.com"}`), "")
+		want := `{"authHeader": "REDACTED","webhookURL":"https://example.com"}`
+		if got != want {
+			t.Errorf("redactBody() = %s, want %s", got, want)
+		}
+	})
+}
+
 func TestNewClient_WithLogger(t *testing.T) {
 	logger := &mockLogger{}
 	client, err := NewClient("test-api-key", WithLogger(logger))
@@ -144,6 +807,153 @@ func TestNewClient_WithLogger(t *testing.T) {
 	}
 }
 
+func TestClient_Ping(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("api-key") != "test-key" {
+				t.Errorf("api-key = %s, want test-key", r.URL.Query().Get("api-key"))
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`[]`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		if err := client.Ping(context.Background()); err != nil {
+			t.Errorf("Ping returned error: %v", err)
+		}
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("bad-key", WithAPIURL(server.URL))
+		err := client.Ping(context.Background())
+		if !errors.Is(err, ErrInvalidAPIKey) {
+			t.Errorf("err = %v, want ErrInvalidAPIKey", err)
+		}
+		if apiErr, ok := IsAPIError(err); !ok || !apiErr.IsUnauthorized() {
+			t.Error("error should wrap the 401 APIError")
+		}
+	})
+
+	t.Run("network error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL), WithMaxRetries(0))
+		err := client.Ping(context.Background())
+		if err == nil {
+			t.Fatal("Ping should return error when server is unreachable")
+		}
+		if errors.Is(err, ErrInvalidAPIKey) {
+			t.Error("network error should not be reported as invalid API key")
+		}
+	})
+}
+
+func TestWithAPIKeyContext(t *testing.T) {
+	var gotKey string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotKey = r.URL.Query().Get("api-key")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("default-key", WithAPIURL(server.URL))
+
+	t.Run("override", func(t *testing.T) {
+		ctx := WithAPIKeyContext(context.Background(), "tenant-key")
+		if _, err := client.ListWebhooks(ctx); err != nil {
+			t.Fatalf("ListWebhooks returned error: %v", err)
+		}
+		if gotKey != "tenant-key" {
+			t.Errorf("api-key = %s, want tenant-key", gotKey)
+		}
+	})
+
+	t.Run("default", func(t *testing.T) {
+		if _, err := client.ListWebhooks(context.Background()); err != nil {
+			t.Fatalf("ListWebhooks returned error: %v", err)
+		}
+		if gotKey != "default-key" {
+			t.Errorf("api-key = %s, want default-key", gotKey)
+		}
+	})
+
+	t.Run("empty override uses default", func(t *testing.T) {
+		ctx := WithAPIKeyContext(context.Background(), "")
+		if _, err := client.ListWebhooks(ctx); err != nil {
+			t.Fatalf("ListWebhooks returned error: %v", err)
+		}
+		if gotKey != "default-key" {
+			t.Errorf("api-key = %s, want default-key", gotKey)
+		}
+	})
+}
+
+func TestWithIdempotencyKey(t *testing.T) {
+	headers := make(map[string]string)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers[r.Method] = r.Header.Get(IdempotencyKeyHeader)
+		if r.Method == http.MethodGet {
+			w.Write([]byte(`[]`))
+			return
+		}
+		w.Write([]byte(`{"webhookID":"webhook-123"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-key", WithAPIURL(server.URL))
+	ctx := WithIdempotencyKey(context.Background(), "op-1")
+
+	t.Run("sent on writes", func(t *testing.T) {
+		if _, err := client.CreateWebhook(ctx, &CreateWebhookRequest{
+			WebhookURL:       "https://example.com/webhook",
+			TransactionTypes: []TransactionType{TransactionTypeSwap},
+			AccountAddresses: []string{"address1"},
+		}); err != nil {
+			t.Fatalf("CreateWebhook returned error: %v", err)
+		}
+		if _, err := client.UpdateWebhook(ctx, "webhook-123", &UpdateWebhookRequest{
+			WebhookURL: "https://example.com/webhook",
+		}); err != nil {
+			t.Fatalf("UpdateWebhook returned error: %v", err)
+		}
+		for _, method := range []string{http.MethodPost, http.MethodPut} {
+			if headers[method] != "op-1" {
+				t.Errorf("%s %s = %q, want op-1", method, IdempotencyKeyHeader, headers[method])
+			}
+		}
+	})
+
+	t.Run("not sent on reads", func(t *testing.T) {
+		if _, err := client.ListWebhooks(ctx); err != nil {
+			t.Fatalf("ListWebhooks returned error: %v", err)
+		}
+		if headers[http.MethodGet] != "" {
+			t.Errorf("GET %s = %q, want none", IdempotencyKeyHeader, headers[http.MethodGet])
+		}
+	})
+
+	t.Run("not sent without a key", func(t *testing.T) {
+		if _, err := client.CreateWebhook(context.Background(), &CreateWebhookRequest{
+			WebhookURL:       "https://example.com/webhook",
+			TransactionTypes: []TransactionType{TransactionTypeSwap},
+			AccountAddresses: []string{"address1"},
+		}); err != nil {
+			t.Fatalf("CreateWebhook returned error: %v", err)
+		}
+		if headers[http.MethodPost] != "" {
+			t.Errorf("POST %s = %q, want none", IdempotencyKeyHeader, headers[http.MethodPost])
+		}
+	})
+}
+
 func TestClient_RPCURL(t *testing.T) {
 	client, err := NewClient("my-secret-key")
 	if err != nil {
@@ -160,6 +970,65 @@ func TestClient_RPCURL(t *testing.T) {
 	}
 }
 
+func TestClient_HTTPClient(t *testing.T) {
+	t.Run("custom client", func(t *testing.T) {
+		custom := &http.Client{Timeout: 42 * time.Second}
+		client, _ := NewClient("test-key", WithHTTPClient(custom))
+		if client.HTTPClient() != custom {
+			t.Error("HTTPClient() should return the client passed to WithHTTPClient")
+		}
+	})
+
+	t.Run("default retrying client", func(t *testing.T) {
+		client, _ := NewClient("test-key", WithTimeout(7*time.Second))
+		httpClient := client.HTTPClient()
+		if httpClient == nil {
+			t.Fatal("HTTPClient() returned nil")
+		}
+		if httpClient.Timeout != 7*time.Second {
+			t.Errorf("Timeout = %v, want 7s", httpClient.Timeout)
+		}
+		if _, ok := httpClient.Transport.(*retryablehttp.RoundTripper); !ok {
+			t.Errorf("Transport = %T, want *retryablehttp.RoundTripper", httpClient.Transport)
+		}
+	})
+}
+
+func TestClient_WebSocketURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []Option
+		expected string
+	}{
+		{
+			name:     "mainnet",
+			expected: "wss://mainnet.helius-rpc.com/?api-key=my-secret-key",
+		},
+		{
+			name:     "devnet",
+			opts:     []Option{WithNetwork(Devnet)},
+			expected: "wss://devnet.helius-rpc.com/?api-key=my-secret-key",
+		},
+		{
+			name:     "custom http url",
+			opts:     []Option{WithRPCURL("http://localhost:8899")},
+			expected: "ws://localhost:8899/?api-key=my-secret-key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClient("my-secret-key", tt.opts...)
+			if err != nil {
+				t.Fatalf("NewClient returned error: %v", err)
+			}
+			if got := client.WebSocketURL(); got != tt.expected {
+				t.Errorf("WebSocketURL() = %s, want %s", got, tt.expected)
+			}
+		})
+	}
+}
+
 func TestClient_doRequest(t *testing.T) {
 	t.Run("successful request", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -250,6 +1119,35 @@ func TestClient_doRequest(t *testing.T) {
 	})
 }
 
+func TestClient_RetryRespectsDeadline(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-key", WithAPIURL(server.URL), WithMaxRetries(10))
+
+	const budget = 300 * time.Millisecond
+	ctx, cancel := context.WithTimeout(context.Background(), budget)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.doGet(ctx, "/test")
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("doGet should return error when server always fails")
+	}
+	if elapsed > budget+200*time.Millisecond {
+		t.Errorf("call took %v, want at most about %v", elapsed, budget)
+	}
+	if n := atomic.LoadInt32(&calls); n < 1 {
+		t.Errorf("server calls = %d, want at least 1", n)
+	}
+}
+
 func TestClient_doGet(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != "GET" {
@@ -295,6 +1193,56 @@ func TestClient_doPost(t *testing.T) {
 	}
 }
 
+func TestClient_RawCall(t *testing.T) {
+	type newFeatureRequest struct {
+		Mint    string `json:"mint"`
+		Verbose bool   `json:"verbose"`
+	}
+	type newFeatureResponse struct {
+		Mint  string `json:"mint"`
+		Score int    `json:"score"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/new-feature":
+			if r.Method != http.MethodPost {
+				t.Errorf("method = %s, want POST", r.Method)
+			}
+			if r.URL.Query().Get("api-key") != "test-key" || r.URL.Query().Get("mode") != "fast" {
+				t.Errorf("query = %s, want api-key and mode", r.URL.RawQuery)
+			}
+			var req newFeatureRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			json.NewEncoder(w).Encode(newFeatureResponse{Mint: req.Mint, Score: 42})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("no such endpoint"))
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-key", WithAPIURL(server.URL))
+
+	t.Run("round trips custom types", func(t *testing.T) {
+		var out newFeatureResponse
+		err := client.RawCall(context.Background(), http.MethodPost, "/new-feature?mode=fast", newFeatureRequest{Mint: "mint-1", Verbose: true}, &out)
+		if err != nil {
+			t.Fatalf("RawCall returned error: %v", err)
+		}
+		if out != (newFeatureResponse{Mint: "mint-1", Score: 42}) {
+			t.Errorf("out = %+v, want {mint-1 42}", out)
+		}
+	})
+
+	t.Run("api error", func(t *testing.T) {
+		err := client.RawCall(context.Background(), http.MethodGet, "/missing", nil, nil)
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("err = %v, want ErrNotFound", err)
+		}
+	})
+}
+
 func TestNetworkConstants(t *testing.T) {
 	if Mainnet != "mainnet" {
 		t.Errorf("Mainnet = %s, want mainnet", Mainnet)
@@ -0,0 +1,44 @@
+package helius
+
+import (
+	"context"
+	"time"
+)
+
+// clock is the source of time for the client's own waits and time
+// calculations, so tests can control time instead of sleeping. The retries
+// performed by the retryablehttp transport use real time regardless.
+type clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// Sleep waits for d, or until ctx is done, in which case it returns
+	// ctx.Err().
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+// realClock is the clock used unless withClock is given.
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// withClock makes the client use clk instead of the system clock. It is
+// unexported because it exists for tests.
+func withClock(clk clock) Option {
+	return func(c *config) {
+		c.clock = clk
+	}
+}
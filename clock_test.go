@@ -0,0 +1,153 @@
+package helius
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a clock whose time only moves when Sleep or Advance is
+// called, so waits complete immediately.
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func newFakeClock(now time.Time) *fakeClock {
+	return &fakeClock{now: now}
+}
+
+func (f *fakeClock) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+func (f *fakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sleeps = append(f.sleeps, d)
+	f.now = f.now.Add(d)
+	return nil
+}
+
+func (f *fakeClock) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+}
+
+func TestRealClock(t *testing.T) {
+	t.Run("sleeps", func(t *testing.T) {
+		start := time.Now()
+		if err := (realClock{}).Sleep(context.Background(), 10*time.Millisecond); err != nil {
+			t.Fatalf("Sleep returned error: %v", err)
+		}
+		if elapsed := time.Since(start); elapsed < 10*time.Millisecond {
+			t.Errorf("Sleep returned after %v, want at least 10ms", elapsed)
+		}
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if err := (realClock{}).Sleep(ctx, time.Hour); err != context.Canceled {
+			t.Errorf("Sleep = %v, want context.Canceled", err)
+		}
+	})
+}
+
+func TestWithClock(t *testing.T) {
+	t.Run("page retry backoff", func(t *testing.T) {
+		page2Calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+			if req["cursor"] == nil {
+				json.NewEncoder(w).Encode(TokenHoldersPage{
+					Cursor:       "page-2",
+					TokenHolders: []TokenHolder{{Owner: "holder-1"}},
+				})
+				return
+			}
+			page2Calls++
+			if page2Calls <= 2 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			json.NewEncoder(w).Encode(TokenHoldersPage{
+				TokenHolders: []TokenHolder{{Owner: "holder-2"}},
+			})
+		}))
+		defer server.Close()
+
+		clk := newFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		client, _ := NewClient("test-key", WithAPIURL(server.URL), WithMaxRetries(0), WithPageRetries(2), withClock(clk))
+		client.retryWaitMin = time.Hour
+
+		holders, err := client.GetAllTokenHolders(context.Background(), "some-mint")
+		if err != nil {
+			t.Fatalf("GetAllTokenHolders returned error: %v", err)
+		}
+		if len(holders) != 2 {
+			t.Errorf("len(holders) = %d, want 2", len(holders))
+		}
+		if want := []time.Duration{time.Hour, 2 * time.Hour}; !reflect.DeepEqual(clk.sleeps, want) {
+			t.Errorf("sleeps = %v, want %v", clk.sleeps, want)
+		}
+		if want := time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC); !clk.Now().Equal(want) {
+			t.Errorf("Now() = %v, want %v", clk.Now(), want)
+		}
+	})
+
+	t.Run("retry-after date", func(t *testing.T) {
+		clk := newFakeClock(time.Date(2001, 2, 3, 4, 5, 0, 0, time.UTC))
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", clk.Now().Add(30*time.Second).Format(http.TimeFormat))
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL), WithoutRetries(), withClock(clk))
+		_, err := client.GetAsset(context.Background(), "asset-1")
+		apiErr, ok := IsAPIError(err)
+		if !ok {
+			t.Fatalf("err = %v, want *APIError", err)
+		}
+		if apiErr.RetryAfter != 30*time.Second {
+			t.Errorf("RetryAfter = %v, want 30s", apiErr.RetryAfter)
+		}
+
+		// The same date has passed once the clock moves beyond it.
+		clk.Advance(time.Minute)
+		server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", time.Date(2001, 2, 3, 4, 5, 30, 0, time.UTC).Format(http.TimeFormat))
+			w.WriteHeader(http.StatusTooManyRequests)
+		})
+		_, err = client.GetAsset(context.Background(), "asset-1")
+		if apiErr, ok := IsAPIError(err); !ok || apiErr.RetryAfter != 0 {
+			t.Errorf("err = %v, want *APIError with no RetryAfter", err)
+		}
+	})
+
+	t.Run("clone keeps clock", func(t *testing.T) {
+		clk := newFakeClock(time.Unix(0, 0))
+		client, _ := NewClient("test-key", withClock(clk))
+		clone, err := client.Clone()
+		if err != nil {
+			t.Fatalf("Clone returned error: %v", err)
+		}
+		if clone.clock != clk {
+			t.Error("Clone did not keep the clock")
+		}
+	})
+}
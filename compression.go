@@ -0,0 +1,140 @@
+package helius
+
+import (
+	"context"
+	"fmt"
+)
+
+// CompressionInfo holds a compressed NFT's leaf data decoded to raw bytes,
+// ready to build Bubblegum instructions such as transfer or burn. Combine it
+// with the Merkle proof from GetAssetProof.
+type CompressionInfo struct {
+	// Tree is the address of the Merkle tree holding the asset.
+	Tree [32]byte
+
+	// DataHash is the hash of the asset's metadata.
+	DataHash [32]byte
+
+	// CreatorHash is the hash of the asset's creators.
+	CreatorHash [32]byte
+
+	// AssetHash is the hash of the leaf itself.
+	AssetHash [32]byte
+
+	// LeafID is the leaf's index in the tree, used as the instruction's
+	// nonce and index.
+	LeafID int64
+
+	// Seq is the tree sequence number at the asset's last update.
+	Seq int64
+}
+
+// CompressionInfo returns the asset's decoded compression data, and false if
+// the asset is not compressed or its hashes are malformed. Use
+// DecodeCompression to find out why.
+func (a *Asset) CompressionInfo() (*CompressionInfo, bool) {
+	info, err := a.DecodeCompression()
+	return info, err == nil
+}
+
+// DecodeCompression is like CompressionInfo but reports the reason for a
+// failure: ErrNotCompressed for an uncompressed asset, or a *ValidationError
+// naming the first field that is not a base58-encoded 32-byte value.
+// AssetHash is optional and left zero when absent.
+func (a *Asset) DecodeCompression() (*CompressionInfo, error) {
+	if !a.IsCompressed() {
+		return nil, ErrNotCompressed
+	}
+	c := a.Compression
+
+	var info CompressionInfo
+	var err error
+	if info.Tree, err = decode32("compression.tree", "address", c.Tree); err != nil {
+		return nil, err
+	}
+	if info.DataHash, err = decode32("compression.data_hash", "hash", c.DataHash); err != nil {
+		return nil, err
+	}
+	if info.CreatorHash, err = decode32("compression.creator_hash", "hash", c.CreatorHash); err != nil {
+		return nil, err
+	}
+	if c.AssetHash != "" {
+		if info.AssetHash, err = decode32("compression.asset_hash", "hash", c.AssetHash); err != nil {
+			return nil, err
+		}
+	}
+	info.LeafID = c.LeafID
+	info.Seq = c.Seq
+
+	return &info, nil
+}
+
+// AssetProof is the Merkle proof for a compressed NFT.
+type AssetProof struct {
+	// Root is the current root of the tree.
+	Root string `json:"root"`
+
+	// Proof lists the sibling hashes from the leaf up to the root.
+	Proof []string `json:"proof"`
+
+	// NodeIndex is the index of the leaf node in the tree.
+	NodeIndex int64 `json:"node_index"`
+
+	// Leaf is the hash of the leaf.
+	Leaf string `json:"leaf"`
+
+	// TreeID is the address of the Merkle tree.
+	TreeID string `json:"tree_id"`
+}
+
+// Decode returns the proof's root and sibling hashes as raw bytes, or a
+// *ValidationError naming the first malformed hash.
+func (p *AssetProof) Decode() (root [32]byte, proof [][32]byte, err error) {
+	if root, err = decode32("root", "hash", p.Root); err != nil {
+		return root, nil, err
+	}
+	proof = make([][32]byte, len(p.Proof))
+	for i, node := range p.Proof {
+		if proof[i], err = decode32(fmt.Sprintf("proof[%d]", i), "hash", node); err != nil {
+			return root, nil, err
+		}
+	}
+	return root, proof, nil
+}
+
+// GetAssetProof fetches the Merkle proof for a compressed NFT.
+//
+// Example:
+//
+//	info, ok := asset.CompressionInfo()
+//	proof, err := client.GetAssetProof(ctx, asset.ID)
+//	root, path, err := proof.Decode()
+func (c *Client) GetAssetProof(ctx context.Context, id string) (*AssetProof, error) {
+	if id == "" {
+		return nil, &ValidationError{
+			Field:   "id",
+			Message: "asset ID is required",
+		}
+	}
+	if err := c.checkAddress("id", id); err != nil {
+		return nil, err
+	}
+
+	reqBody := map[string]interface{}{
+		"id": id,
+	}
+
+	body, err := c.doPost(ctx, "/assets/proof", reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var proof AssetProof
+	if err := c.decode(body, &proof); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+
+	c.logger.Debug("fetched asset proof", "id", id, "depth", len(proof.Proof))
+
+	return &proof, nil
+}
@@ -0,0 +1,148 @@
+package helius
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// A compressed NFT as returned by getAsset, trimmed to the relevant fields.
+const testCompressedAsset = `{
+	"id": "JDuAmJgJ7fmUJhtsiTUvdqYzznuEygrkwQZGbsoTCs6w",
+	"interface": "V1_NFT",
+	"compression": {
+		"eligible": false,
+		"compressed": true,
+		"data_hash": "6pVhKbpD1hmRiNZcA8d2fUAeLrp2DpgqtK2SJ6d9Wp7N",
+		"creator_hash": "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v",
+		"asset_hash": "BGUMAp9Gq7iTEuizy4pqaxsTyUCBK68MDfK752saRPUY",
+		"tree": "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA",
+		"seq": 1042,
+		"leaf_id": 1041
+	}
+}`
+
+func TestAsset_CompressionInfo(t *testing.T) {
+	t.Run("compressed asset", func(t *testing.T) {
+		var asset Asset
+		if err := json.Unmarshal([]byte(testCompressedAsset), &asset); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+
+		info, ok := asset.CompressionInfo()
+		if !ok {
+			_, err := asset.DecodeCompression()
+			t.Fatalf("CompressionInfo() not ok: %v", err)
+		}
+
+		checks := []struct {
+			name string
+			got  [32]byte
+			want string
+		}{
+			{"Tree", info.Tree, asset.Compression.Tree},
+			{"DataHash", info.DataHash, asset.Compression.DataHash},
+			{"CreatorHash", info.CreatorHash, asset.Compression.CreatorHash},
+			{"AssetHash", info.AssetHash, asset.Compression.AssetHash},
+		}
+		for _, c := range checks {
+			if got := encodeBase58(c.got[:]); got != c.want {
+				t.Errorf("%s = %s, want %s", c.name, got, c.want)
+			}
+		}
+		if info.LeafID != 1041 || info.Seq != 1042 {
+			t.Errorf("LeafID/Seq = %d/%d, want 1041/1042", info.LeafID, info.Seq)
+		}
+	})
+
+	t.Run("uncompressed asset", func(t *testing.T) {
+		asset := &Asset{ID: "nft", Compression: &Compression{Eligible: true}}
+		if _, ok := asset.CompressionInfo(); ok {
+			t.Error("CompressionInfo() should be false for an uncompressed asset")
+		}
+		if _, err := asset.DecodeCompression(); !errors.Is(err, ErrNotCompressed) {
+			t.Errorf("err = %v, want ErrNotCompressed", err)
+		}
+	})
+
+	t.Run("malformed hash", func(t *testing.T) {
+		asset := &Asset{Compression: &Compression{
+			Compressed:  true,
+			Tree:        "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA",
+			DataHash:    "6pVhKbpD1hmRiNZcA8d2fUAeLrp2DpgqtK2SJ6d9Wp7N",
+			CreatorHash: "not-a-hash",
+		}}
+		if _, ok := asset.CompressionInfo(); ok {
+			t.Error("CompressionInfo() should be false for a malformed hash")
+		}
+		_, err := asset.DecodeCompression()
+		if valErr, ok := IsValidationError(err); !ok || valErr.Field != "compression.creator_hash" {
+			t.Errorf("err = %v, want ValidationError for compression.creator_hash", err)
+		}
+	})
+}
+
+func TestGetAssetProof(t *testing.T) {
+	t.Run("successful get", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/assets/proof" {
+				t.Errorf("expected /assets/proof, got %s", r.URL.Path)
+			}
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+			if req["id"] != "cnft-1" {
+				t.Errorf("id = %v, want cnft-1", req["id"])
+			}
+
+			w.Write([]byte(`{
+				"root": "6pVhKbpD1hmRiNZcA8d2fUAeLrp2DpgqtK2SJ6d9Wp7N",
+				"proof": ["EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", "11111111111111111111111111111111"],
+				"node_index": 17425,
+				"leaf": "BGUMAp9Gq7iTEuizy4pqaxsTyUCBK68MDfK752saRPUY",
+				"tree_id": "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"
+			}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		proof, err := client.GetAssetProof(context.Background(), "cnft-1")
+		if err != nil {
+			t.Fatalf("GetAssetProof returned error: %v", err)
+		}
+		if proof.NodeIndex != 17425 || proof.TreeID != "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA" {
+			t.Errorf("proof = %+v, unexpected NodeIndex or TreeID", proof)
+		}
+
+		root, path, err := proof.Decode()
+		if err != nil {
+			t.Fatalf("Decode returned error: %v", err)
+		}
+		if encodeBase58(root[:]) != proof.Root {
+			t.Errorf("root = %s, want %s", encodeBase58(root[:]), proof.Root)
+		}
+		if len(path) != 2 || path[1] != [32]byte{} {
+			t.Errorf("path = %v, want 2 nodes ending in the zero hash", path)
+		}
+	})
+
+	t.Run("malformed proof node", func(t *testing.T) {
+		proof := &AssetProof{
+			Root:  "6pVhKbpD1hmRiNZcA8d2fUAeLrp2DpgqtK2SJ6d9Wp7N",
+			Proof: []string{"EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", "abc"},
+		}
+		_, _, err := proof.Decode()
+		if valErr, ok := IsValidationError(err); !ok || valErr.Field != "proof[1]" {
+			t.Errorf("err = %v, want ValidationError for proof[1]", err)
+		}
+	})
+
+	t.Run("empty id", func(t *testing.T) {
+		client, _ := NewClient("test-key")
+		if _, err := client.GetAssetProof(context.Background(), ""); err == nil {
+			t.Error("expected error for empty id")
+		}
+	})
+}
@@ -3,7 +3,13 @@ package helius
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
 )
 
 // Asset represents a digital asset (NFT or token) from the DAS API.
@@ -38,11 +44,32 @@ type Asset struct {
 	// TokenInfo contains additional token info.
 	TokenInfo *TokenInfo `json:"token_info,omitempty"`
 
+	// TokenStandard is the Metaplex token standard (e.g., "ProgrammableNonFungible").
+	// DAS also reports this under content.metadata.token_standard.
+	TokenStandard string `json:"token_standard,omitempty"`
+
 	// Mutable indicates if the asset metadata can be changed.
 	Mutable bool `json:"mutable"`
 
 	// Burnt indicates if the asset has been burned.
 	Burnt bool `json:"burnt"`
+
+	// Inscription contains inscription data (requires ShowInscription).
+	Inscription *Inscription `json:"inscription,omitempty"`
+
+	// Spl20 contains SPL-20 token data for inscribed assets (requires ShowInscription).
+	Spl20 map[string]interface{} `json:"spl20,omitempty"`
+}
+
+// Inscription contains data about an inscribed asset.
+type Inscription struct {
+	Order                  int64  `json:"order"`
+	Size                   int64  `json:"size"`
+	ContentType            string `json:"contentType,omitempty"`
+	Encoding               string `json:"encoding,omitempty"`
+	ValidationHash         string `json:"validationHash,omitempty"`
+	InscriptionDataAccount string `json:"inscriptionDataAccount,omitempty"`
+	Authority              string `json:"authority,omitempty"`
 }
 
 // AssetContent contains asset metadata and media.
@@ -102,6 +129,14 @@ type Ownership struct {
 	Delegate       string `json:"delegate,omitempty"`
 	OwnershipModel string `json:"ownership_model"`
 	Owner          string `json:"owner"`
+
+	// ProgrammableConfig is set for programmable NFTs with a transfer rule set.
+	ProgrammableConfig *ProgrammableConfig `json:"programmable_config,omitempty"`
+}
+
+// ProgrammableConfig contains the transfer rule configuration of a pNFT.
+type ProgrammableConfig struct {
+	RuleSet string `json:"rule_set,omitempty"`
 }
 
 // Supply contains token supply information.
@@ -137,6 +172,110 @@ type AssetsPage struct {
 	Cursor        string   `json:"cursor,omitempty"`
 	Items         []Asset  `json:"items"`
 	NativeBalance *Balance `json:"nativeBalance,omitempty"`
+
+	// GrandTotal is the number of matching assets across all pages. It is
+	// only reported when ShowGrandTotal is set, and is zero otherwise.
+	GrandTotal int `json:"grand_total,omitempty"`
+}
+
+// jsonAliases reports the extra key accepted by UnmarshalJSON to
+// WithStrictJSON.
+func (p *AssetsPage) jsonAliases() []string {
+	return []string{"grandTotal"}
+}
+
+// UnmarshalJSON decodes a page, also accepting the grand total under the
+// camel-case "grandTotal" key that some DAS responses use.
+func (p *AssetsPage) UnmarshalJSON(data []byte) error {
+	type assetsPage AssetsPage
+	aux := struct {
+		*assetsPage
+		GrandTotalCamel *int `json:"grandTotal"`
+	}{assetsPage: (*assetsPage)(p)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if p.GrandTotal == 0 && aux.GrandTotalCamel != nil {
+		p.GrandTotal = *aux.GrandTotalCamel
+	}
+	return nil
+}
+
+// TotalPages returns the number of pages of Limit items needed to hold Total
+// items. It returns 0 when Limit is 0, since the page size is unknown.
+func (p *AssetsPage) TotalPages() int {
+	if p.Limit <= 0 {
+		return 0
+	}
+	return (p.Total + p.Limit - 1) / p.Limit
+}
+
+// HasNextPage reports whether more results are available, either because a
+// cursor was returned or because the current page ends before Total.
+func (p *AssetsPage) HasNextPage() bool {
+	if p.Cursor != "" {
+		return true
+	}
+	return p.Page > 0 && p.Page*p.Limit < p.Total
+}
+
+// GroupByCollection buckets Items by collection address. Assets that are not
+// part of a collection are grouped under the empty-string key.
+func (p *AssetsPage) GroupByCollection() map[string][]Asset {
+	groups := make(map[string][]Asset)
+	for _, asset := range p.Items {
+		key := asset.CollectionAddress()
+		groups[key] = append(groups[key], asset)
+	}
+	return groups
+}
+
+// FilterFrozen returns the Items whose ownership is frozen.
+func (p *AssetsPage) FilterFrozen() []Asset {
+	var frozen []Asset
+	for i := range p.Items {
+		if p.Items[i].IsFrozen() {
+			frozen = append(frozen, p.Items[i])
+		}
+	}
+	return frozen
+}
+
+// FilterDelegated returns the Items that have a delegate.
+func (p *AssetsPage) FilterDelegated() []Asset {
+	var delegated []Asset
+	for i := range p.Items {
+		if p.Items[i].IsDelegated() {
+			delegated = append(delegated, p.Items[i])
+		}
+	}
+	return delegated
+}
+
+// AssetRef is a slimmed-down Asset holding only what is needed to identify
+// an asset and its owner.
+type AssetRef struct {
+	ID        string
+	Interface string
+	Owner     string
+}
+
+// Lightweight returns an AssetRef for each of the Items, in order. Keeping
+// the refs and dropping the page lets large responses be garbage collected
+// when only IDs and owners are needed downstream. Owner is empty for assets
+// without ownership data.
+func (p *AssetsPage) Lightweight() []AssetRef {
+	refs := make([]AssetRef, len(p.Items))
+	for i := range p.Items {
+		refs[i] = AssetRef{
+			ID:        p.Items[i].ID,
+			Interface: p.Items[i].Interface,
+		}
+		if p.Items[i].Ownership != nil {
+			refs[i].Owner = p.Items[i].Ownership.Owner
+		}
+	}
+	return refs
 }
 
 // Balance represents a native SOL balance.
@@ -146,28 +285,214 @@ type Balance struct {
 	TotalPrice  float64 `json:"total_price,omitempty"`
 }
 
+// Name returns the asset's name from its metadata, or "" if absent.
+func (a *Asset) Name() string {
+	return a.metadataString("name")
+}
+
+// Description returns the asset's description from its metadata, or "" if absent.
+func (a *Asset) Description() string {
+	return a.metadataString("description")
+}
+
+// ImageURL returns the asset's image URL, or "" if absent.
+//
+// Content.Links["image"] is preferred; otherwise the first file with an
+// image/* MIME type is used.
+func (a *Asset) ImageURL() string {
+	if a.Content == nil {
+		return ""
+	}
+	if image := a.Content.Links["image"]; image != "" {
+		return image
+	}
+	for _, f := range a.Content.Files {
+		if strings.HasPrefix(f.Mime, "image/") {
+			return f.URI
+		}
+	}
+	return ""
+}
+
+// CollectionAddress returns the address of the asset's collection, or "" if
+// the asset is not part of a collection.
+func (a *Asset) CollectionAddress() string {
+	for _, g := range a.Grouping {
+		if g.GroupKey == "collection" {
+			return g.GroupValue
+		}
+	}
+	return ""
+}
+
+// IsCompressed reports whether the asset is a compressed NFT.
+func (a *Asset) IsCompressed() bool {
+	return a.Compression != nil && a.Compression.Compressed
+}
+
+// Exists reports whether a holds a real asset, i.e. has an ID. A zero Asset,
+// as decoded from an empty or null DAS response, does not exist.
+func (a *Asset) Exists() bool {
+	return a != nil && a.ID != ""
+}
+
+// IsFungible reports whether the asset is a fungible token or asset.
+func (a *Asset) IsFungible() bool {
+	switch a.Interface {
+	case "FungibleToken", "FungibleAsset":
+		return true
+	}
+	return false
+}
+
+// IsNFT reports whether the asset is a non-fungible token of any standard,
+// including programmable, print edition, and Metaplex Core assets.
+func (a *Asset) IsNFT() bool {
+	switch a.Interface {
+	case "V1_NFT", "V2_NFT", "V1_PRINT", "LEGACY_NFT", "ProgrammableNFT", "MplCoreAsset":
+		return true
+	}
+	return false
+}
+
+// IsProgrammable reports whether the asset is a programmable NFT (pNFT),
+// whose transfers are subject to its rule set.
+func (a *Asset) IsProgrammable() bool {
+	if a.Interface == "ProgrammableNFT" {
+		return true
+	}
+	standard := a.TokenStandard
+	if standard == "" {
+		standard = a.metadataString("token_standard")
+	}
+	return standard == "ProgrammableNonFungible" || standard == "ProgrammableNonFungibleEdition"
+}
+
+// PriceUSD returns the token's price per token in US dollars, and false if
+// no price is available. Prices are only returned for fungible tokens, and
+// only when the asset is fetched with ShowFungible set.
+//
+// Helius quotes prices in USDC, which is treated as USD.
+func (a *Asset) PriceUSD() (float64, bool) {
+	if a.TokenInfo == nil || a.TokenInfo.PriceInfo == nil {
+		return 0, false
+	}
+	switch a.TokenInfo.PriceInfo.Currency {
+	case "", "USDC", "USD":
+		return a.TokenInfo.PriceInfo.PricePerToken, true
+	}
+	return 0, false
+}
+
+// AssociatedTokenAccount returns the owner's associated token account for a
+// fungible asset, and false if TokenInfo does not report one. DAS reports it
+// when fungible assets are listed for an owner with ShowFungible set.
+func (a *Asset) AssociatedTokenAccount() (string, bool) {
+	if a.TokenInfo == nil || a.TokenInfo.AssociatedTokenAddress == "" {
+		return "", false
+	}
+	return a.TokenInfo.AssociatedTokenAddress, true
+}
+
+// Balance returns the owner's raw token balance and the token's decimals
+// from TokenInfo, and false if the asset has no TokenInfo. As with
+// AssociatedTokenAccount, the balance is only meaningful when the asset was
+// listed for an owner.
+//
+// Example:
+//
+//	if raw, decimals, ok := asset.Balance(); ok {
+//	    fmt.Printf("%.*f\n", decimals, float64(raw)/math.Pow10(decimals))
+//	}
+func (a *Asset) Balance() (int64, int, bool) {
+	if a.TokenInfo == nil {
+		return 0, 0, false
+	}
+	return a.TokenInfo.Balance, a.TokenInfo.Decimals, true
+}
+
+// IsFrozen reports whether the asset is frozen and so cannot be transferred.
+func (a *Asset) IsFrozen() bool {
+	return a.Ownership != nil && a.Ownership.Frozen
+}
+
+// IsDelegated reports whether another account has been delegated authority
+// over the asset.
+func (a *Asset) IsDelegated() bool {
+	return a.Ownership != nil && a.Ownership.Delegated
+}
+
+// metadataString returns the string value of key in Content.Metadata.
+func (a *Asset) metadataString(key string) string {
+	if a.Content == nil {
+		return ""
+	}
+	v, _ := a.Content.Metadata[key].(string)
+	return v
+}
+
 // GetAssetOptions configures the GetAsset request.
 type GetAssetOptions struct {
-	ShowFungible           bool `json:"showFungible,omitempty"`
+	ShowFungible              bool `json:"showFungible,omitempty"`
 	ShowUnverifiedCollections bool `json:"showUnverifiedCollections,omitempty"`
-	ShowCollectionMetadata bool `json:"showCollectionMetadata,omitempty"`
-	ShowGrandTotal         bool `json:"showGrandTotal,omitempty"`
-	ShowInscription        bool `json:"showInscription,omitempty"`
+	ShowCollectionMetadata    bool `json:"showCollectionMetadata,omitempty"`
+	ShowGrandTotal            bool `json:"showGrandTotal,omitempty"`
+	ShowInscription           bool `json:"showInscription,omitempty"`
+}
+
+// displayOptions returns the DAS displayOptions object for o, or nil if no
+// option is set.
+func (o *GetAssetOptions) displayOptions() map[string]bool {
+	if o == nil {
+		return nil
+	}
+
+	displayOpts := map[string]bool{}
+	if o.ShowFungible {
+		displayOpts["showFungible"] = true
+	}
+	if o.ShowUnverifiedCollections {
+		displayOpts["showUnverifiedCollections"] = true
+	}
+	if o.ShowCollectionMetadata {
+		displayOpts["showCollectionMetadata"] = true
+	}
+	if o.ShowGrandTotal {
+		displayOpts["showGrandTotal"] = true
+	}
+	if o.ShowInscription {
+		displayOpts["showInscription"] = true
+	}
+	if len(displayOpts) == 0 {
+		return nil
+	}
+	return displayOpts
 }
 
 // GetAsset fetches a single asset by its ID (mint address).
 func (c *Client) GetAsset(ctx context.Context, id string) (*Asset, error) {
+	return c.GetAssetWithOptions(ctx, id, nil)
+}
+
+// GetAssetWithOptions fetches a single asset by its ID with the given display
+// options, e.g. ShowInscription to include inscription and SPL-20 data.
+func (c *Client) GetAssetWithOptions(ctx context.Context, id string, opts *GetAssetOptions) (*Asset, error) {
 	if id == "" {
-		return nil, &APIError{
-			StatusCode: 400,
-			Message:    "asset ID is required",
-			Path:       "/assets",
+		return nil, &ValidationError{
+			Field:   "id",
+			Message: "asset ID is required",
 		}
 	}
+	if err := c.checkAddress("id", id); err != nil {
+		return nil, err
+	}
 
 	reqBody := map[string]interface{}{
 		"id": id,
 	}
+	if displayOpts := opts.displayOptions(); displayOpts != nil {
+		reqBody["displayOptions"] = displayOpts
+	}
 
 	body, err := c.doPost(ctx, "/assets", reqBody)
 	if err != nil {
@@ -175,52 +500,261 @@ func (c *Client) GetAsset(ctx context.Context, id string) (*Asset, error) {
 	}
 
 	var asset Asset
-	if err := json.Unmarshal(body, &asset); err != nil {
+	if err := c.decode(body, &asset); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 
+	// DAS may answer a lookup for an unknown ID with 200 and an empty or null
+	// asset rather than a 404. Report it as a 404 so callers can rely on
+	// IsNotFound and ErrNotFound either way.
+	if !asset.Exists() {
+		return nil, &APIError{
+			StatusCode: http.StatusNotFound,
+			Message:    "asset not found",
+			Path:       "/assets",
+		}
+	}
+
 	c.logger.Debug("fetched asset", "id", id, "interface", asset.Interface)
 
 	return &asset, nil
 }
 
+const (
+	// maxMetadataSize caps the size of off-chain metadata fetched by
+	// GetAssetWithMetadata.
+	maxMetadataSize = 1 << 20 // 1 MiB
+
+	// metadataTimeout bounds the off-chain metadata fetch.
+	metadataTimeout = 10 * time.Second
+)
+
+// OwnsAsset reports whether owner currently owns the asset with ID assetID,
+// e.g. to gate access on holding an NFT.
+//
+// Fungible tokens have many owners and no single Ownership.Owner, so OwnsAsset
+// always returns false for them; use GetTokenHolders or a wallet's balances
+// instead. If the asset does not exist, the error matches ErrNotFound.
+func (c *Client) OwnsAsset(ctx context.Context, owner, assetID string) (bool, error) {
+	if owner == "" {
+		return false, &ValidationError{
+			Field:   "owner",
+			Message: "owner address is required",
+		}
+	}
+
+	asset, err := c.GetAsset(ctx, assetID)
+	if err != nil {
+		return false, err
+	}
+
+	if asset.IsFungible() || asset.Ownership == nil {
+		return false, nil
+	}
+	return asset.Ownership.Owner == owner, nil
+}
+
+// GetAssetWithMetadata fetches an asset and resolves its off-chain JSON
+// metadata from Content.JSONUri.
+//
+// This is useful for older collections whose on-chain data is sparse. The
+// metadata fetch is limited to 1 MiB and 10 seconds. If it fails, the asset is
+// still returned along with a *MetadataError; if the asset has no JSON URI,
+// the metadata is nil and no error is returned.
+func (c *Client) GetAssetWithMetadata(ctx context.Context, id string) (*Asset, map[string]interface{}, error) {
+	asset, err := c.GetAsset(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if asset.Content == nil || asset.Content.JSONUri == "" {
+		return asset, nil, nil
+	}
+
+	uri := asset.Content.JSONUri
+	metadata, err := c.fetchMetadata(ctx, uri)
+	if err != nil {
+		c.logger.Warn("failed to fetch asset metadata", "id", id, "uri", uri, "error", err)
+		return asset, nil, &MetadataError{URI: uri, Err: err}
+	}
+
+	return asset, metadata, nil
+}
+
+// fetchMetadata GETs and decodes the JSON document at uri.
+func (c *Client) fetchMetadata(ctx context.Context, uri string) (map[string]interface{}, error) {
+	ctx, cancel := context.WithTimeout(ctx, metadataTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("do request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxMetadataSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	if len(body) > maxMetadataSize {
+		return nil, fmt.Errorf("metadata exceeds %d bytes", maxMetadataSize)
+	}
+
+	var metadata map[string]interface{}
+	if err := json.Unmarshal(body, &metadata); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return metadata, nil
+}
+
 // AssetsByOwnerOptions configures the GetAssetsByOwner request.
+//
+// Results are paginated either by Page or by the keyset cursors Cursor,
+// Before and After. The two are mutually exclusive: GetAssetsByOwner rejects
+// a Page combined with any cursor.
 type AssetsByOwnerOptions struct {
-	Page                   int     `json:"page,omitempty"`
-	Limit                  int     `json:"limit,omitempty"`
-	Cursor                 string  `json:"cursor,omitempty"`
-	Before                 string  `json:"before,omitempty"`
-	After                  string  `json:"after,omitempty"`
-	ShowFungible           bool    `json:"showFungible,omitempty"`
-	ShowNativeBalance      bool    `json:"showNativeBalance,omitempty"`
-	ShowUnverifiedCollections bool `json:"showUnverifiedCollections,omitempty"`
-	ShowCollectionMetadata bool    `json:"showCollectionMetadata,omitempty"`
-	ShowGrandTotal         bool    `json:"showGrandTotal,omitempty"`
-	ShowZeroBalance        bool    `json:"showZeroBalance,omitempty"`
-	SortBy                 *SortBy `json:"sortBy,omitempty"`
+	Page                      int     `json:"page,omitempty"`
+	Limit                     int     `json:"limit,omitempty"`
+	Cursor                    string  `json:"cursor,omitempty"`
+	Before                    string  `json:"before,omitempty"`
+	After                     string  `json:"after,omitempty"`
+	ShowFungible              bool    `json:"showFungible,omitempty"`
+	ShowNativeBalance         bool    `json:"showNativeBalance,omitempty"`
+	ShowUnverifiedCollections bool    `json:"showUnverifiedCollections,omitempty"`
+	ShowCollectionMetadata    bool    `json:"showCollectionMetadata,omitempty"`
+	ShowGrandTotal            bool    `json:"showGrandTotal,omitempty"`
+	ShowZeroBalance           bool    `json:"showZeroBalance,omitempty"`
+	SortBy                    *SortBy `json:"sortBy,omitempty"`
+
+	// TokenType restricts results to one kind of token, e.g. only
+	// TokenTypeNonFungible to skip a wallet's fungible holdings.
+	TokenType TokenType `json:"tokenType,omitempty"`
 }
 
 // SortBy configures sorting for asset queries.
 type SortBy struct {
-	SortBy        string `json:"sortBy"`        // "created", "updated", "recent_action"
-	SortDirection string `json:"sortDirection"` // "asc", "desc"
+	SortBy        string `json:"sortBy"`        // See the SortField constants
+	SortDirection string `json:"sortDirection"` // SortAsc or SortDesc
+}
+
+// NewSortBy returns a SortBy sorting on field in direction dir.
+//
+// Example:
+//
+//	opts := &helius.AssetsByOwnerOptions{
+//	    SortBy: helius.NewSortBy(helius.SortByRecentAction, helius.SortDesc),
+//	}
+func NewSortBy(field SortField, dir SortDirection) *SortBy {
+	return &SortBy{SortBy: string(field), SortDirection: string(dir)}
+}
+
+// SortField is the field DAS sorts asset queries on.
+type SortField string
+
+const (
+	// SortByCreated sorts by creation time.
+	SortByCreated SortField = "created"
+	// SortByUpdated sorts by last update time.
+	SortByUpdated SortField = "updated"
+	// SortByRecentAction sorts by the asset's most recent action.
+	SortByRecentAction SortField = "recent_action"
+	// SortByNone leaves results unsorted, which is fastest.
+	SortByNone SortField = "none"
+)
+
+// IsKnown reports whether f is one of the SortField constants.
+func (f SortField) IsKnown() bool {
+	switch f {
+	case SortByCreated, SortByUpdated, SortByRecentAction, SortByNone:
+		return true
+	}
+	return false
+}
+
+// SortDirection is the direction of a SortBy.
+type SortDirection string
+
+const (
+	// SortAsc sorts in ascending order.
+	SortAsc SortDirection = "asc"
+	// SortDesc sorts in descending order.
+	SortDesc SortDirection = "desc"
+)
+
+// IsKnown reports whether d is one of the SortDirection constants.
+func (d SortDirection) IsKnown() bool {
+	return d == SortAsc || d == SortDesc
+}
+
+// checkSortBy validates s in strict mode.
+func (c *Client) checkSortBy(s *SortBy) error {
+	if !c.strict || s == nil {
+		return nil
+	}
+	if !SortField(s.SortBy).IsKnown() {
+		return &ValidationError{
+			Field:   "sortBy.sortBy",
+			Message: fmt.Sprintf("unknown sort field %q", s.SortBy),
+		}
+	}
+	if !SortDirection(s.SortDirection).IsKnown() {
+		return &ValidationError{
+			Field:   "sortBy.sortDirection",
+			Message: fmt.Sprintf("unknown sort direction %q", s.SortDirection),
+		}
+	}
+	return nil
+}
+
+// checkPagination returns a ValidationError if page is combined with any of
+// the keyset cursors, which DAS rejects.
+func checkPagination(page int, cursor, before, after string) error {
+	if page <= 0 {
+		return nil
+	}
+	for _, c := range []struct{ name, value string }{
+		{"cursor", cursor},
+		{"before", before},
+		{"after", after},
+	} {
+		if c.value != "" {
+			return &ValidationError{
+				Field:   "page",
+				Message: fmt.Sprintf("page cannot be combined with %s; use one pagination mode", c.name),
+			}
+		}
+	}
+	return nil
 }
 
 // GetAssetsByOwner fetches all assets owned by an address.
 func (c *Client) GetAssetsByOwner(ctx context.Context, ownerAddress string, opts *AssetsByOwnerOptions) (*AssetsPage, error) {
 	if ownerAddress == "" {
-		return nil, &APIError{
-			StatusCode: 400,
-			Message:    "owner address is required",
-			Path:       "/assets",
+		return nil, &ValidationError{
+			Field:   "ownerAddress",
+			Message: "owner address is required",
 		}
 	}
+	if err := c.checkAddress("ownerAddress", ownerAddress); err != nil {
+		return nil, err
+	}
 
 	reqBody := map[string]interface{}{
 		"ownerAddress": ownerAddress,
 	}
 
 	if opts != nil {
+		if err := checkPagination(opts.Page, opts.Cursor, opts.Before, opts.After); err != nil {
+			return nil, err
+		}
 		if opts.Page > 0 {
 			reqBody["page"] = opts.Page
 		}
@@ -236,6 +770,15 @@ func (c *Client) GetAssetsByOwner(ctx context.Context, ownerAddress string, opts
 		if opts.After != "" {
 			reqBody["after"] = opts.After
 		}
+		if opts.TokenType != "" {
+			if c.strict && !opts.TokenType.IsKnown() {
+				return nil, &ValidationError{
+					Field:   "tokenType",
+					Message: fmt.Sprintf("unknown token type %q", opts.TokenType),
+				}
+			}
+			reqBody["tokenType"] = opts.TokenType
+		}
 
 		displayOpts := map[string]bool{}
 		if opts.ShowFungible {
@@ -261,6 +804,9 @@ func (c *Client) GetAssetsByOwner(ctx context.Context, ownerAddress string, opts
 		}
 
 		if opts.SortBy != nil {
+			if err := c.checkSortBy(opts.SortBy); err != nil {
+				return nil, err
+			}
 			reqBody["sortBy"] = opts.SortBy
 		}
 	}
@@ -271,7 +817,7 @@ func (c *Client) GetAssetsByOwner(ctx context.Context, ownerAddress string, opts
 	}
 
 	var page AssetsPage
-	if err := json.Unmarshal(body, &page); err != nil {
+	if err := c.decode(body, &page); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 
@@ -284,44 +830,476 @@ func (c *Client) GetAssetsByOwner(ctx context.Context, ownerAddress string, opts
 	return &page, nil
 }
 
+// GetAssetsByOwners fetches a page of assets for each of owners, as by
+// GetAssetsByOwner with the same opts, and returns them keyed by owner.
+// Duplicate owners are fetched once.
+//
+// Requests run concurrently, up to the limit set with WithFanoutConcurrency.
+// A failure for one owner does not stop the others: the map holds every page
+// that was fetched, and the failures are combined with errors.Join, each
+// naming its owner. If ctx is cancelled, owners not yet started are skipped
+// and ctx.Err() is included in the returned error.
+func (c *Client) GetAssetsByOwners(ctx context.Context, owners []string, opts *AssetsByOwnerOptions) (map[string]*AssetsPage, error) {
+	workers := c.fanout
+	if workers < 1 {
+		workers = 1
+	}
+
+	var (
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+		pages = make(map[string]*AssetsPage, len(owners))
+		errs  []error
+	)
+	sem := make(chan struct{}, workers)
+
+	for _, owner := range dedupeIDs(owners) {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if err := ctx.Err(); err != nil {
+			mu.Lock()
+			errs = append(errs, err)
+			mu.Unlock()
+			break
+		}
+
+		wg.Add(1)
+		go func(owner string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			page, err := c.GetAssetsByOwner(ctx, owner, opts)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("owner %s: %w", owner, err))
+				return
+			}
+			pages[owner] = page
+		}(owner)
+	}
+
+	wg.Wait()
+
+	return pages, errors.Join(errs...)
+}
+
+// StreamAssetsByOwner enumerates all assets owned by an address, following
+// pagination cursors and emitting assets one at a time. Pages are fetched as
+// by AssetsByOwnerPaginator, so opts.Page is ignored, transient failures are
+// retried and the WithMaxPages limit applies.
+//
+// Only one page is held in memory at a time, so wallets with very many assets
+// can be processed without buffering them all. Both channels are closed when
+// enumeration ends; at most one error (including ctx.Err() on cancellation)
+// is sent on the error channel. Callers should drain the asset channel until
+// it is closed, then check the error channel.
+//
+// Example:
+//
+//	assets, errs := client.StreamAssetsByOwner(ctx, owner, &helius.AssetsByOwnerOptions{Limit: 1000})
+//	for asset := range assets {
+//	    fmt.Println(asset.ID)
+//	}
+//	if err := <-errs; err != nil {
+//	    log.Fatal(err)
+//	}
+func (c *Client) StreamAssetsByOwner(ctx context.Context, ownerAddress string, opts *AssetsByOwnerOptions) (<-chan Asset, <-chan error) {
+	assets := make(chan Asset)
+	errs := make(chan error, 1)
+
+	p := c.AssetsByOwnerPaginator(ownerAddress, opts)
+
+	go func() {
+		defer close(errs)
+		defer close(assets)
+
+		for {
+			asset, err := p.Next(ctx)
+			if errors.Is(err, ErrIteratorDone) {
+				return
+			}
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			select {
+			case assets <- asset:
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			}
+		}
+	}()
+
+	return assets, errs
+}
+
+// AssetsByGroupOptions configures the GetAssetsByGroup request.
+type AssetsByGroupOptions struct {
+	Page                      int     `json:"page,omitempty"`
+	Limit                     int     `json:"limit,omitempty"`
+	Cursor                    string  `json:"cursor,omitempty"`
+	Before                    string  `json:"before,omitempty"`
+	After                     string  `json:"after,omitempty"`
+	ShowUnverifiedCollections bool    `json:"showUnverifiedCollections,omitempty"`
+	ShowCollectionMetadata    bool    `json:"showCollectionMetadata,omitempty"`
+	ShowGrandTotal            bool    `json:"showGrandTotal,omitempty"`
+	SortBy                    *SortBy `json:"sortBy,omitempty"`
+}
+
+// GetAssetsByGroup fetches assets belonging to a group, such as all assets
+// in a collection (groupKey "collection", groupValue the collection address).
+func (c *Client) GetAssetsByGroup(ctx context.Context, groupKey, groupValue string, opts *AssetsByGroupOptions) (*AssetsPage, error) {
+	if groupKey == "" {
+		return nil, &ValidationError{
+			Field:   "groupKey",
+			Message: "group key is required",
+		}
+	}
+	if groupValue == "" {
+		return nil, &ValidationError{
+			Field:   "groupValue",
+			Message: "group value is required",
+		}
+	}
+
+	reqBody := map[string]interface{}{
+		"groupKey":   groupKey,
+		"groupValue": groupValue,
+	}
+
+	if opts != nil {
+		if opts.Page > 0 {
+			reqBody["page"] = opts.Page
+		}
+		if opts.Limit > 0 {
+			reqBody["limit"] = opts.Limit
+		}
+		if opts.Cursor != "" {
+			reqBody["cursor"] = opts.Cursor
+		}
+		if opts.Before != "" {
+			reqBody["before"] = opts.Before
+		}
+		if opts.After != "" {
+			reqBody["after"] = opts.After
+		}
+
+		displayOpts := map[string]bool{}
+		if opts.ShowUnverifiedCollections {
+			displayOpts["showUnverifiedCollections"] = true
+		}
+		if opts.ShowCollectionMetadata {
+			displayOpts["showCollectionMetadata"] = true
+		}
+		if opts.ShowGrandTotal {
+			displayOpts["showGrandTotal"] = true
+		}
+		if len(displayOpts) > 0 {
+			reqBody["displayOptions"] = displayOpts
+		}
+
+		if opts.SortBy != nil {
+			if err := c.checkSortBy(opts.SortBy); err != nil {
+				return nil, err
+			}
+			reqBody["sortBy"] = opts.SortBy
+		}
+	}
+
+	body, err := c.doPost(ctx, "/assets/group", reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var page AssetsPage
+	if err := c.decode(body, &page); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+
+	c.logger.Debug("fetched assets by group",
+		"groupKey", groupKey,
+		"groupValue", groupValue,
+		"total", page.Total,
+		"returned", len(page.Items),
+	)
+
+	return &page, nil
+}
+
+// AssetsByCreatorOptions configures the GetAssetsByCreator request.
+type AssetsByCreatorOptions struct {
+	Page   int
+	Limit  int
+	Cursor string
+
+	// OnlyVerified, if set, is sent as creatorVerified: true matches only
+	// assets on which the creator is verified, false only those on which it
+	// is not, and nil either. A creator can be verified on some of its
+	// assets and not others.
+	OnlyVerified *bool
+
+	// GroupKey and GroupValue narrow the results to a group, such as a
+	// collection (GroupKey "collection").
+	GroupKey   string
+	GroupValue string
+
+	// AuthorityAddress narrows the results to assets with this update
+	// authority.
+	AuthorityAddress string
+
+	SortBy *SortBy
+}
+
+// GetAssetsByCreator fetches the assets listing creatorAddress among their
+// creators. It is a search, so opts can combine it with a group or an
+// authority.
+//
+// Example:
+//
+//	verified := true
+//	page, err := client.GetAssetsByCreator(ctx, creator, &helius.AssetsByCreatorOptions{
+//	    OnlyVerified: &verified,
+//	    GroupKey:     "collection",
+//	    GroupValue:   collectionAddress,
+//	})
+func (c *Client) GetAssetsByCreator(ctx context.Context, creatorAddress string, opts *AssetsByCreatorOptions) (*AssetsPage, error) {
+	if creatorAddress == "" {
+		return nil, &ValidationError{
+			Field:   "creatorAddress",
+			Message: "creator address is required",
+		}
+	}
+	if err := c.checkAddress("creatorAddress", creatorAddress); err != nil {
+		return nil, err
+	}
+
+	search := &SearchAssetsOptions{CreatorAddress: creatorAddress}
+	if opts != nil {
+		search.Page = opts.Page
+		search.Limit = opts.Limit
+		search.Cursor = opts.Cursor
+		search.CreatorVerified = opts.OnlyVerified
+		search.GroupKey = opts.GroupKey
+		search.GroupValue = opts.GroupValue
+		search.AuthorityAddress = opts.AuthorityAddress
+		search.SortBy = opts.SortBy
+	}
+	return c.SearchAssets(ctx, search)
+}
+
+// AssetsByAuthorityOptions configures the GetAssetsByAuthority request.
+type AssetsByAuthorityOptions struct {
+	Page   int
+	Limit  int
+	Cursor string
+
+	// CreatorAddress narrows the results to assets listing this creator.
+	CreatorAddress string
+
+	// OnlyVerified, if set, is sent as creatorVerified and applies to
+	// CreatorAddress, which it requires; see AssetsByCreatorOptions.
+	OnlyVerified *bool
+
+	// GroupKey and GroupValue narrow the results to a group, such as a
+	// collection (GroupKey "collection").
+	GroupKey   string
+	GroupValue string
+
+	SortBy *SortBy
+}
+
+// GetAssetsByAuthority fetches the assets whose update authority is
+// authorityAddress. It is a search, so opts can combine it with a creator or
+// a group.
+func (c *Client) GetAssetsByAuthority(ctx context.Context, authorityAddress string, opts *AssetsByAuthorityOptions) (*AssetsPage, error) {
+	if authorityAddress == "" {
+		return nil, &ValidationError{
+			Field:   "authorityAddress",
+			Message: "authority address is required",
+		}
+	}
+	if err := c.checkAddress("authorityAddress", authorityAddress); err != nil {
+		return nil, err
+	}
+
+	search := &SearchAssetsOptions{AuthorityAddress: authorityAddress}
+	if opts != nil {
+		if opts.OnlyVerified != nil && opts.CreatorAddress == "" {
+			return nil, &ValidationError{
+				Field:   "onlyVerified",
+				Message: "onlyVerified requires creatorAddress",
+			}
+		}
+		search.Page = opts.Page
+		search.Limit = opts.Limit
+		search.Cursor = opts.Cursor
+		search.CreatorAddress = opts.CreatorAddress
+		search.CreatorVerified = opts.OnlyVerified
+		search.GroupKey = opts.GroupKey
+		search.GroupValue = opts.GroupValue
+		search.SortBy = opts.SortBy
+	}
+	return c.SearchAssets(ctx, search)
+}
+
+// GetCollectionSize returns the number of assets in a collection, fetching
+// only a single asset to do so. The size is the grand total DAS reports when
+// asked for it, since the page total only counts the returned asset.
+//
+// The count covers assets indexed by DAS, so for compressed NFTs it is the
+// supply minted on the tree, including burnt assets the indexer still tracks.
+func (c *Client) GetCollectionSize(ctx context.Context, collectionAddress string) (int, error) {
+	if collectionAddress == "" {
+		return 0, &ValidationError{
+			Field:   "collectionAddress",
+			Message: "collection address is required",
+		}
+	}
+
+	page, err := c.GetAssetsByGroup(ctx, "collection", collectionAddress, &AssetsByGroupOptions{
+		Limit:          1,
+		ShowGrandTotal: true,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return page.GrandTotal, nil
+}
+
+// AssetInterface is a DAS asset interface, used to filter SearchAssets.
+type AssetInterface string
+
+const (
+	// InterfaceV1NFT is a Metaplex token metadata NFT.
+	InterfaceV1NFT AssetInterface = "V1_NFT"
+	// InterfaceV1Print is a print edition of a V1 NFT.
+	InterfaceV1Print AssetInterface = "V1_PRINT"
+	// InterfaceLegacyNFT is an NFT minted before token metadata standards.
+	InterfaceLegacyNFT AssetInterface = "LEGACY_NFT"
+	// InterfaceV2NFT is a V2 NFT.
+	InterfaceV2NFT AssetInterface = "V2_NFT"
+	// InterfaceProgrammableNFT is a Metaplex programmable NFT.
+	InterfaceProgrammableNFT AssetInterface = "ProgrammableNFT"
+	// InterfaceFungibleToken is a fungible SPL token with decimals.
+	InterfaceFungibleToken AssetInterface = "FungibleToken"
+	// InterfaceFungibleAsset is a semi-fungible token with zero decimals.
+	InterfaceFungibleAsset AssetInterface = "FungibleAsset"
+	// InterfaceMplCoreAsset is a Metaplex Core asset.
+	InterfaceMplCoreAsset AssetInterface = "MplCoreAsset"
+	// InterfaceMplCoreCollection is a Metaplex Core collection.
+	InterfaceMplCoreCollection AssetInterface = "MplCoreCollection"
+	// InterfaceCustom is an asset with a custom interface.
+	InterfaceCustom AssetInterface = "Custom"
+	// InterfaceIdentity is an identity asset.
+	InterfaceIdentity AssetInterface = "Identity"
+	// InterfaceExecutable is an executable program account.
+	InterfaceExecutable AssetInterface = "Executable"
+
+	// InterfaceUnknown is returned by Asset.InterfaceType for interfaces not
+	// listed above. It is never sent to the API.
+	InterfaceUnknown AssetInterface = "Unknown"
+)
+
+// IsKnown reports whether i is one of the AssetInterface constants other
+// than InterfaceUnknown.
+func (i AssetInterface) IsKnown() bool {
+	switch i {
+	case InterfaceV1NFT, InterfaceV1Print, InterfaceLegacyNFT, InterfaceV2NFT,
+		InterfaceProgrammableNFT, InterfaceFungibleToken, InterfaceFungibleAsset,
+		InterfaceMplCoreAsset, InterfaceMplCoreCollection, InterfaceCustom,
+		InterfaceIdentity, InterfaceExecutable:
+		return true
+	}
+	return false
+}
+
+// InterfaceType returns Interface as an AssetInterface, or InterfaceUnknown
+// if it is not one of the known constants, so that callers can switch over
+// the constants exhaustively. Interface keeps the raw value.
+func (a *Asset) InterfaceType() AssetInterface {
+	if i := AssetInterface(a.Interface); i.IsKnown() {
+		return i
+	}
+	return InterfaceUnknown
+}
+
+// TokenType selects which kinds of tokens SearchAssets returns.
+type TokenType string
+
+const (
+	// TokenTypeFungible returns only fungible tokens.
+	TokenTypeFungible TokenType = "fungible"
+	// TokenTypeNonFungible returns all NFTs, compressed or not.
+	TokenTypeNonFungible TokenType = "nonFungible"
+	// TokenTypeRegularNFT returns only uncompressed NFTs.
+	TokenTypeRegularNFT TokenType = "regularNft"
+	// TokenTypeCompressedNFT returns only compressed NFTs.
+	TokenTypeCompressedNFT TokenType = "compressedNft"
+	// TokenTypeAll returns fungible and non-fungible tokens alike, so a
+	// single search can list everything in a wallet.
+	TokenTypeAll TokenType = "all"
+)
+
+// IsKnown reports whether t is one of the TokenType constants.
+func (t TokenType) IsKnown() bool {
+	switch t {
+	case TokenTypeFungible, TokenTypeNonFungible, TokenTypeRegularNFT, TokenTypeCompressedNFT, TokenTypeAll:
+		return true
+	}
+	return false
+}
+
 // SearchAssetsOptions configures the SearchAssets request.
 type SearchAssetsOptions struct {
-	Page                   int     `json:"page,omitempty"`
-	Limit                  int     `json:"limit,omitempty"`
-	Cursor                 string  `json:"cursor,omitempty"`
-	OwnerAddress           string  `json:"ownerAddress,omitempty"`
-	CreatorAddress         string  `json:"creatorAddress,omitempty"`
-	CreatorVerified        *bool   `json:"creatorVerified,omitempty"`
-	AuthorityAddress       string  `json:"authorityAddress,omitempty"`
-	GroupKey               string  `json:"groupKey,omitempty"`
-	GroupValue             string  `json:"groupValue,omitempty"`
-	Delegate               string  `json:"delegate,omitempty"`
-	Frozen                 *bool   `json:"frozen,omitempty"`
-	Supply                 *int64  `json:"supply,omitempty"`
-	SupplyMint             string  `json:"supplyMint,omitempty"`
-	Compressed             *bool   `json:"compressed,omitempty"`
-	Compressible           *bool   `json:"compressible,omitempty"`
-	RoyaltyTargetType      string  `json:"royaltyTargetType,omitempty"`
-	RoyaltyTarget          string  `json:"royaltyTarget,omitempty"`
-	RoyaltyAmount          *int    `json:"royaltyAmount,omitempty"`
-	Burnt                  *bool   `json:"burnt,omitempty"`
-	Interface              string  `json:"interface,omitempty"`
-	TokenType              string  `json:"tokenType,omitempty"`
-	OwnerType              string  `json:"ownerType,omitempty"`
-	SpecificationVersion   string  `json:"specificationVersion,omitempty"`
-	ShowFungible           bool    `json:"showFungible,omitempty"`
-	ShowCollectionMetadata bool    `json:"showCollectionMetadata,omitempty"`
-	SortBy                 *SortBy `json:"sortBy,omitempty"`
-	JsonUri                string  `json:"jsonUri,omitempty"`
+	Page                   int            `json:"page,omitempty"`
+	Limit                  int            `json:"limit,omitempty"`
+	Cursor                 string         `json:"cursor,omitempty"`
+	Before                 string         `json:"before,omitempty"`
+	After                  string         `json:"after,omitempty"`
+	OwnerAddress           string         `json:"ownerAddress,omitempty"`
+	CreatorAddress         string         `json:"creatorAddress,omitempty"`
+	CreatorVerified        *bool          `json:"creatorVerified,omitempty"`
+	AuthorityAddress       string         `json:"authorityAddress,omitempty"`
+	GroupKey               string         `json:"groupKey,omitempty"`
+	GroupValue             string         `json:"groupValue,omitempty"`
+	Delegate               string         `json:"delegate,omitempty"`
+	Frozen                 *bool          `json:"frozen,omitempty"`
+	Supply                 *int64         `json:"supply,omitempty"`
+	SupplyMint             string         `json:"supplyMint,omitempty"`
+	Compressed             *bool          `json:"compressed,omitempty"`
+	Compressible           *bool          `json:"compressible,omitempty"`
+	RoyaltyTargetType      string         `json:"royaltyTargetType,omitempty"`
+	RoyaltyTarget          string         `json:"royaltyTarget,omitempty"`
+	RoyaltyAmount          *int           `json:"royaltyAmount,omitempty"`
+	Burnt                  *bool          `json:"burnt,omitempty"`
+	Interface              AssetInterface `json:"interface,omitempty"`
+	TokenType              TokenType      `json:"tokenType,omitempty"`
+	OwnerType              string         `json:"ownerType,omitempty"`
+	SpecificationVersion   string         `json:"specificationVersion,omitempty"`
+	ShowFungible           bool           `json:"showFungible,omitempty"`
+	ShowCollectionMetadata bool           `json:"showCollectionMetadata,omitempty"`
+	SortBy                 *SortBy        `json:"sortBy,omitempty"`
+	JsonUri                string         `json:"jsonUri,omitempty"`
 }
 
 // SearchAssets searches for assets matching the given criteria.
 func (c *Client) SearchAssets(ctx context.Context, opts *SearchAssetsOptions) (*AssetsPage, error) {
 	if opts == nil {
-		return nil, &APIError{
-			StatusCode: 400,
-			Message:    "search options are required",
-			Path:       "/assets/search",
+		return nil, &ValidationError{
+			Field:   "opts",
+			Message: "search options are required",
+		}
+	}
+	if c.strict && opts.TokenType != "" && !opts.TokenType.IsKnown() {
+		return nil, &ValidationError{
+			Field:   "tokenType",
+			Message: fmt.Sprintf("unknown token type %q", opts.TokenType),
 		}
 	}
 
@@ -336,6 +1314,12 @@ func (c *Client) SearchAssets(ctx context.Context, opts *SearchAssetsOptions) (*
 	if opts.Cursor != "" {
 		reqBody["cursor"] = opts.Cursor
 	}
+	if opts.Before != "" {
+		reqBody["before"] = opts.Before
+	}
+	if opts.After != "" {
+		reqBody["after"] = opts.After
+	}
 	if opts.OwnerAddress != "" {
 		reqBody["ownerAddress"] = opts.OwnerAddress
 	}
@@ -360,9 +1344,27 @@ func (c *Client) SearchAssets(ctx context.Context, opts *SearchAssetsOptions) (*
 	if opts.Frozen != nil {
 		reqBody["frozen"] = *opts.Frozen
 	}
+	if opts.Supply != nil {
+		reqBody["supply"] = *opts.Supply
+	}
+	if opts.SupplyMint != "" {
+		reqBody["supplyMint"] = opts.SupplyMint
+	}
 	if opts.Compressed != nil {
 		reqBody["compressed"] = *opts.Compressed
 	}
+	if opts.Compressible != nil {
+		reqBody["compressible"] = *opts.Compressible
+	}
+	if opts.RoyaltyTargetType != "" {
+		reqBody["royaltyTargetType"] = opts.RoyaltyTargetType
+	}
+	if opts.RoyaltyTarget != "" {
+		reqBody["royaltyTarget"] = opts.RoyaltyTarget
+	}
+	if opts.RoyaltyAmount != nil {
+		reqBody["royaltyAmount"] = *opts.RoyaltyAmount
+	}
 	if opts.Burnt != nil {
 		reqBody["burnt"] = *opts.Burnt
 	}
@@ -372,20 +1374,40 @@ func (c *Client) SearchAssets(ctx context.Context, opts *SearchAssetsOptions) (*
 	if opts.TokenType != "" {
 		reqBody["tokenType"] = opts.TokenType
 	}
+	if opts.OwnerType != "" {
+		reqBody["ownerType"] = opts.OwnerType
+	}
+	if opts.SpecificationVersion != "" {
+		reqBody["specificationVersion"] = opts.SpecificationVersion
+	}
 	if opts.JsonUri != "" {
 		reqBody["jsonUri"] = opts.JsonUri
 	}
 	if opts.SortBy != nil {
+		if err := c.checkSortBy(opts.SortBy); err != nil {
+			return nil, err
+		}
 		reqBody["sortBy"] = opts.SortBy
 	}
 
+	displayOpts := map[string]bool{}
+	if opts.ShowFungible {
+		displayOpts["showFungible"] = true
+	}
+	if opts.ShowCollectionMetadata {
+		displayOpts["showCollectionMetadata"] = true
+	}
+	if len(displayOpts) > 0 {
+		reqBody["displayOptions"] = displayOpts
+	}
+
 	body, err := c.doPost(ctx, "/assets/search", reqBody)
 	if err != nil {
 		return nil, err
 	}
 
 	var page AssetsPage
-	if err := json.Unmarshal(body, &page); err != nil {
+	if err := c.decode(body, &page); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 
@@ -394,14 +1416,49 @@ func (c *Client) SearchAssets(ctx context.Context, opts *SearchAssetsOptions) (*
 	return &page, nil
 }
 
+// FindAssetByJsonUri returns the first asset whose metadata URI is exactly
+// uri, e.g. to check that metadata has not already been minted. If no asset
+// matches, it returns a 404 *APIError, so errors.Is(err, ErrNotFound) holds.
+func (c *Client) FindAssetByJsonUri(ctx context.Context, uri string) (*Asset, error) {
+	if uri == "" {
+		return nil, &ValidationError{
+			Field:   "jsonUri",
+			Message: "metadata URI is required",
+		}
+	}
+
+	page, err := c.SearchAssets(ctx, &SearchAssetsOptions{
+		JsonUri: uri,
+		Page:    1,
+		Limit:   1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(page.Items) == 0 {
+		return nil, &APIError{
+			StatusCode: http.StatusNotFound,
+			Message:    "no asset with metadata URI " + uri,
+			Path:       "/assets/search",
+		}
+	}
+	return &page.Items[0], nil
+}
+
 // GetAssetBatch fetches multiple assets by their IDs.
-func (c *Client) GetAssetBatch(ctx context.Context, ids []string) ([]Asset, error) {
+//
+// Duplicate IDs are sent, and returned, only once: the result follows the
+// order in which each ID first appears in ids. An ID that does not resolve to
+// an asset is returned as a nil entry at its position. Use GetAssetBatchMap
+// to look results up by ID, or GetAssets for a result aligned one-to-one with
+// ids.
+func (c *Client) GetAssetBatch(ctx context.Context, ids []string) ([]*Asset, error) {
 	if len(ids) == 0 {
-		return []Asset{}, nil
+		return []*Asset{}, nil
 	}
 
 	reqBody := map[string]interface{}{
-		"ids": ids,
+		"ids": dedupeIDs(ids),
 	}
 
 	body, err := c.doPost(ctx, "/assets/batch", reqBody)
@@ -409,8 +1466,9 @@ func (c *Client) GetAssetBatch(ctx context.Context, ids []string) ([]Asset, erro
 		return nil, err
 	}
 
-	var assets []Asset
-	if err := json.Unmarshal(body, &assets); err != nil {
+	// Missing assets are returned as null entries.
+	var assets []*Asset
+	if err := c.decode(body, &assets); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 
@@ -418,3 +1476,111 @@ func (c *Client) GetAssetBatch(ctx context.Context, ids []string) ([]Asset, erro
 
 	return assets, nil
 }
+
+// GetAssetBatchMap is like GetAssetBatch, but returns the assets keyed by
+// requested ID. Every ID in ids has an entry; it is nil if the asset was not
+// found.
+//
+// Example:
+//
+//	assets, err := client.GetAssetBatchMap(ctx, ids)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for id, asset := range assets {
+//	    if asset == nil {
+//	        fmt.Printf("%s: not found\n", id)
+//	    }
+//	}
+func (c *Client) GetAssetBatchMap(ctx context.Context, ids []string) (map[string]*Asset, error) {
+	assets, err := c.GetAssetBatch(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*Asset, len(ids))
+	for _, id := range ids {
+		byID[id] = nil
+	}
+	for _, a := range assets {
+		if a != nil {
+			if _, ok := byID[a.ID]; ok {
+				byID[a.ID] = a
+			}
+		}
+	}
+	return byID, nil
+}
+
+// dedupeIDs returns ids with duplicates removed, keeping first-seen order.
+func dedupeIDs(ids []string) []string {
+	seen := make(map[string]struct{}, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
+
+// maxAssetBatchSize is the maximum number of IDs per DAS batch request.
+const maxAssetBatchSize = 1000
+
+// GetAssets fetches one or more assets by ID with the given display options.
+//
+// IDs are sent in batches of up to 1000, so any number may be requested. The
+// result has the same length and order as ids: an asset that does not exist
+// is returned as a zero-value Asset (with an empty ID) at its position.
+// Duplicate IDs are requested once but filled in at every position.
+func (c *Client) GetAssets(ctx context.Context, ids []string, opts *GetAssetOptions) ([]Asset, error) {
+	unique := dedupeIDs(ids)
+	byID := make(map[string]*Asset, len(unique))
+
+	for start := 0; start < len(unique); start += maxAssetBatchSize {
+		end := start + maxAssetBatchSize
+		if end > len(unique) {
+			end = len(unique)
+		}
+		chunk := unique[start:end]
+
+		reqBody := map[string]interface{}{
+			"ids": chunk,
+		}
+		if displayOpts := opts.displayOptions(); displayOpts != nil {
+			reqBody["displayOptions"] = displayOpts
+		}
+
+		body, err := c.doPost(ctx, "/assets/batch", reqBody)
+		if err != nil {
+			return nil, err
+		}
+
+		// Missing assets are returned as null entries.
+		var found []*Asset
+		if err := c.decode(body, &found); err != nil {
+			return nil, fmt.Errorf("decode response: %w", err)
+		}
+
+		for _, a := range found {
+			if a != nil {
+				byID[a.ID] = a
+			}
+		}
+	}
+
+	assets := make([]Asset, 0, len(ids))
+	for _, id := range ids {
+		if a, ok := byID[id]; ok {
+			assets = append(assets, *a)
+		} else {
+			assets = append(assets, Asset{})
+		}
+	}
+
+	c.logger.Debug("fetched assets", "requested", len(ids))
+
+	return assets, nil
+}
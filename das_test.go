@@ -1,11 +1,18 @@
 package helius
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestGetAsset(t *testing.T) {
@@ -50,6 +57,9 @@ func TestGetAsset(t *testing.T) {
 		if err == nil {
 			t.Error("GetAsset should return error for empty id")
 		}
+		if _, ok := IsValidationError(err); !ok {
+			t.Errorf("error should be ValidationError, got %T", err)
+		}
 	})
 
 	t.Run("asset with content", func(t *testing.T) {
@@ -114,6 +124,98 @@ func TestGetAsset(t *testing.T) {
 			t.Errorf("LeafID = %d, want 42", asset.Compression.LeafID)
 		}
 	})
+	t.Run("empty asset is not found", func(t *testing.T) {
+		for _, response := range []string{`{}`, `null`} {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(response))
+			}))
+
+			client, _ := NewClient("test-key", WithAPIURL(server.URL))
+			asset, err := client.GetAsset(context.Background(), "missing-mint")
+			server.Close()
+
+			if asset != nil {
+				t.Errorf("response %s: asset = %+v, want nil", response, asset)
+			}
+			apiErr, ok := IsAPIError(err)
+			if !ok || !apiErr.IsNotFound() {
+				t.Errorf("response %s: err = %v, want not-found APIError", response, err)
+			}
+			if !errors.Is(err, ErrNotFound) {
+				t.Errorf("response %s: errors.Is(err, ErrNotFound) = false", response)
+			}
+		}
+	})
+
+	t.Run("exists", func(t *testing.T) {
+		var nilAsset *Asset
+		if nilAsset.Exists() || (&Asset{}).Exists() {
+			t.Error("nil and zero assets should not exist")
+		}
+		if !(&Asset{ID: "mint"}).Exists() {
+			t.Error("asset with an ID should exist")
+		}
+	})
+}
+
+func TestOwnsAsset(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]string
+		json.NewDecoder(r.Body).Decode(&req)
+
+		switch req["id"] {
+		case "nft-1":
+			w.Write([]byte(`{"id":"nft-1","interface":"V1_NFT","ownership":{"owner":"wallet-a"}}`))
+		case "token-1":
+			w.Write([]byte(`{"id":"token-1","interface":"FungibleToken","ownership":{"owner":"wallet-a"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"asset not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-key", WithAPIURL(server.URL))
+
+	tests := []struct {
+		name    string
+		owner   string
+		assetID string
+		want    bool
+	}{
+		{"owned", "wallet-a", "nft-1", true},
+		{"not owned", "wallet-b", "nft-1", false},
+		{"fungible", "wallet-a", "token-1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.OwnsAsset(context.Background(), tt.owner, tt.assetID)
+			if err != nil {
+				t.Fatalf("OwnsAsset returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("OwnsAsset(%s, %s) = %v, want %v", tt.owner, tt.assetID, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("missing asset", func(t *testing.T) {
+		got, err := client.OwnsAsset(context.Background(), "wallet-a", "missing")
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("err = %v, want ErrNotFound", err)
+		}
+		if got {
+			t.Error("OwnsAsset should be false for a missing asset")
+		}
+	})
+
+	t.Run("empty owner", func(t *testing.T) {
+		_, err := client.OwnsAsset(context.Background(), "", "nft-1")
+		if valErr, ok := IsValidationError(err); !ok || valErr.Field != "owner" {
+			t.Errorf("err = %v, want ValidationError for owner", err)
+		}
+	})
 }
 
 func TestGetAssetsByOwner(t *testing.T) {
@@ -157,6 +259,9 @@ func TestGetAssetsByOwner(t *testing.T) {
 		if err == nil {
 			t.Error("GetAssetsByOwner should return error for empty owner address")
 		}
+		if _, ok := IsValidationError(err); !ok {
+			t.Errorf("error should be ValidationError, got %T", err)
+		}
 	})
 
 	t.Run("with pagination options", func(t *testing.T) {
@@ -264,6 +369,251 @@ func TestGetAssetsByOwner(t *testing.T) {
 			t.Errorf("Cursor = %s, want another-cursor", page.Cursor)
 		}
 	})
+
+	t.Run("token type", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+			if req["tokenType"] != "nonFungible" {
+				t.Errorf("tokenType = %v, want nonFungible", req["tokenType"])
+			}
+			json.NewEncoder(w).Encode(AssetsPage{Items: []Asset{{ID: "nft-1"}}})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		_, err := client.GetAssetsByOwner(context.Background(), "owner-wallet", &AssetsByOwnerOptions{
+			TokenType: TokenTypeNonFungible,
+		})
+		if err != nil {
+			t.Fatalf("GetAssetsByOwner returned error: %v", err)
+		}
+	})
+}
+
+func TestGetAssetsByOwner_PaginationModes(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		json.NewEncoder(w).Encode(AssetsPage{Items: []Asset{}})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-key", WithAPIURL(server.URL))
+	const owner = "86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY"
+
+	invalid := []struct {
+		name string
+		opts *AssetsByOwnerOptions
+	}{
+		{"page with cursor", &AssetsByOwnerOptions{Page: 2, Cursor: "cursor"}},
+		{"page with before", &AssetsByOwnerOptions{Page: 2, Before: "asset-1"}},
+		{"page with after", &AssetsByOwnerOptions{Page: 2, After: "asset-1"}},
+		{"page with before and after", &AssetsByOwnerOptions{Page: 1, Before: "asset-9", After: "asset-1"}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			calls = 0
+			_, err := client.GetAssetsByOwner(context.Background(), owner, tt.opts)
+			if valErr, ok := IsValidationError(err); !ok || valErr.Field != "page" {
+				t.Errorf("err = %v, want ValidationError for page", err)
+			}
+			if calls != 0 {
+				t.Errorf("calls = %d, want no request", calls)
+			}
+		})
+	}
+
+	valid := []struct {
+		name string
+		opts *AssetsByOwnerOptions
+	}{
+		{"page only", &AssetsByOwnerOptions{Page: 2}},
+		{"cursor only", &AssetsByOwnerOptions{Cursor: "cursor"}},
+		{"before and after", &AssetsByOwnerOptions{Before: "asset-9", After: "asset-1"}},
+	}
+	for _, tt := range valid {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := client.GetAssetsByOwner(context.Background(), owner, tt.opts); err != nil {
+				t.Errorf("GetAssetsByOwner returned error: %v", err)
+			}
+		})
+	}
+}
+
+func TestGetAssetsByOwners(t *testing.T) {
+	t.Run("partial failure", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+			owner, _ := req["ownerAddress"].(string)
+			if owner == "wallet-bad" {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"error":"invalid owner"}`))
+				return
+			}
+			fmt.Fprintf(w, `{"total":1,"limit":1000,"items":[{"id":"%s-nft"}]}`, owner)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		pages, err := client.GetAssetsByOwners(context.Background(), []string{"wallet-a", "wallet-bad", "wallet-b", "wallet-a"}, nil)
+
+		if err == nil {
+			t.Fatal("expected error for failed owner")
+		}
+		if !strings.Contains(err.Error(), "wallet-bad") {
+			t.Errorf("err = %v, want it to name wallet-bad", err)
+		}
+		if apiErr, ok := IsAPIError(err); !ok || apiErr.StatusCode != http.StatusBadRequest {
+			t.Errorf("err = %v, want it to wrap the APIError", err)
+		}
+
+		if len(pages) != 2 {
+			t.Fatalf("len(pages) = %d, want 2", len(pages))
+		}
+		for _, owner := range []string{"wallet-a", "wallet-b"} {
+			page := pages[owner]
+			if page == nil || len(page.Items) != 1 || page.Items[0].ID != owner+"-nft" {
+				t.Errorf("pages[%s] = %+v, want one %s-nft item", owner, page, owner)
+			}
+		}
+		if _, ok := pages["wallet-bad"]; ok {
+			t.Error("failed owner should not have a page")
+		}
+	})
+
+	t.Run("bounded concurrency", func(t *testing.T) {
+		var inFlight, peak int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			w.Write([]byte(`{"items":[]}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL), WithFanoutConcurrency(2))
+		owners := []string{"o1", "o2", "o3", "o4", "o5", "o6"}
+		pages, err := client.GetAssetsByOwners(context.Background(), owners, nil)
+		if err != nil {
+			t.Fatalf("GetAssetsByOwners returned error: %v", err)
+		}
+		if len(pages) != len(owners) {
+			t.Errorf("len(pages) = %d, want %d", len(pages), len(owners))
+		}
+		if peak > 2 {
+			t.Errorf("peak concurrency = %d, want at most 2", peak)
+		}
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		client, _ := NewClient("test-key", WithAPIURL("http://127.0.0.1:0"))
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		pages, err := client.GetAssetsByOwners(ctx, []string{"wallet-a", "wallet-b"}, nil)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("err = %v, want context.Canceled", err)
+		}
+		if len(pages) != 0 {
+			t.Errorf("len(pages) = %d, want 0", len(pages))
+		}
+	})
+}
+
+func TestGetAssetsByGroup(t *testing.T) {
+	t.Run("forwards group and options", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/assets/group" {
+				t.Errorf("expected /assets/group, got %s", r.URL.Path)
+			}
+
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+			if req["groupKey"] != "collection" || req["groupValue"] != "collection-address" {
+				t.Errorf("group = %v/%v, want collection/collection-address", req["groupKey"], req["groupValue"])
+			}
+			if req["page"] != float64(2) || req["limit"] != float64(50) {
+				t.Errorf("page/limit = %v/%v, want 2/50", req["page"], req["limit"])
+			}
+			display, _ := req["displayOptions"].(map[string]interface{})
+			if display["showCollectionMetadata"] != true {
+				t.Errorf("displayOptions = %v, want showCollectionMetadata", req["displayOptions"])
+			}
+
+			json.NewEncoder(w).Encode(AssetsPage{Total: 1, Items: []Asset{{ID: "asset-1"}}})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		page, err := client.GetAssetsByGroup(context.Background(), "collection", "collection-address", &AssetsByGroupOptions{
+			Page:                   2,
+			Limit:                  50,
+			ShowCollectionMetadata: true,
+		})
+		if err != nil {
+			t.Fatalf("GetAssetsByGroup returned error: %v", err)
+		}
+		if len(page.Items) != 1 || page.Items[0].ID != "asset-1" {
+			t.Errorf("Items = %+v, want [asset-1]", page.Items)
+		}
+	})
+
+	t.Run("missing group", func(t *testing.T) {
+		client, _ := NewClient("test-key")
+		_, err := client.GetAssetsByGroup(context.Background(), "collection", "", nil)
+		if valErr, ok := IsValidationError(err); !ok || valErr.Field != "groupValue" {
+			t.Errorf("err = %v, want ValidationError for groupValue", err)
+		}
+	})
+}
+
+func TestGetCollectionSize(t *testing.T) {
+	t.Run("requests a single asset", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+
+			want := map[string]interface{}{
+				"groupKey":       "collection",
+				"groupValue":     "collection-address",
+				"limit":          float64(1),
+				"displayOptions": map[string]interface{}{"showGrandTotal": true},
+			}
+			if !reflect.DeepEqual(req, want) {
+				t.Errorf("request = %v, want %v", req, want)
+			}
+
+			// total only counts the returned page; grand_total is the
+			// collection size.
+			w.Write([]byte(`{"total":1,"limit":1,"grand_total":10000,"items":[{"id":"asset-1"}]}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		size, err := client.GetCollectionSize(context.Background(), "collection-address")
+		if err != nil {
+			t.Fatalf("GetCollectionSize returned error: %v", err)
+		}
+		if size != 10000 {
+			t.Errorf("size = %d, want 10000", size)
+		}
+	})
+
+	t.Run("empty collection address", func(t *testing.T) {
+		client, _ := NewClient("test-key")
+		_, err := client.GetCollectionSize(context.Background(), "")
+		if valErr, ok := IsValidationError(err); !ok || valErr.Field != "collectionAddress" {
+			t.Errorf("err = %v, want ValidationError for collectionAddress", err)
+		}
+	})
 }
 
 func TestSearchAssets(t *testing.T) {
@@ -306,6 +656,9 @@ func TestSearchAssets(t *testing.T) {
 		if err == nil {
 			t.Error("SearchAssets should return error for nil options")
 		}
+		if _, ok := IsValidationError(err); !ok {
+			t.Errorf("error should be ValidationError, got %T", err)
+		}
 	})
 
 	t.Run("search by collection", func(t *testing.T) {
@@ -360,106 +713,561 @@ func TestSearchAssets(t *testing.T) {
 	})
 }
 
-func TestGetAssetBatch(t *testing.T) {
-	t.Run("successful batch", func(t *testing.T) {
-		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if r.URL.Path != "/assets/batch" {
-				t.Errorf("expected /assets/batch, got %s", r.URL.Path)
-			}
+func TestFindAssetByJsonUri(t *testing.T) {
+	const uri = "https://arweave.net/metadata-1.json"
 
-			var req map[string][]string
-			json.NewDecoder(r.Body).Decode(&req)
-			if len(req["ids"]) != 3 {
-				t.Errorf("len(ids) = %d, want 3", len(req["ids"]))
-			}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/assets/search" {
+			t.Errorf("expected /assets/search, got %s", r.URL.Path)
+		}
+		var req map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&req)
 
-			w.WriteHeader(http.StatusOK)
-			json.NewEncoder(w).Encode([]Asset{
-				{ID: "asset-1"},
-				{ID: "asset-2"},
-				{ID: "asset-3"},
-			})
-		}))
-		defer server.Close()
+		if req["jsonUri"] == uri {
+			w.Write([]byte(`{"total":1,"limit":1,"page":1,"items":[{"id":"minted-asset","content":{"json_uri":"https://arweave.net/metadata-1.json"}}]}`))
+			return
+		}
+		w.Write([]byte(`{"total":0,"limit":1,"page":1,"items":[]}`))
+	}))
+	defer server.Close()
 
-		client, _ := NewClient("test-key", WithAPIURL(server.URL))
-		assets, err := client.GetAssetBatch(context.Background(), []string{"asset-1", "asset-2", "asset-3"})
+	client, _ := NewClient("test-key", WithAPIURL(server.URL))
 
+	t.Run("match", func(t *testing.T) {
+		asset, err := client.FindAssetByJsonUri(context.Background(), uri)
 		if err != nil {
-			t.Fatalf("GetAssetBatch returned error: %v", err)
+			t.Fatalf("FindAssetByJsonUri returned error: %v", err)
 		}
-		if len(assets) != 3 {
-			t.Errorf("len(assets) = %d, want 3", len(assets))
+		if asset.ID != "minted-asset" {
+			t.Errorf("ID = %s, want minted-asset", asset.ID)
 		}
 	})
 
-	t.Run("empty ids", func(t *testing.T) {
-		client, _ := NewClient("test-key")
-		assets, err := client.GetAssetBatch(context.Background(), []string{})
-
-		if err != nil {
-			t.Fatalf("GetAssetBatch returned error: %v", err)
+	t.Run("no match", func(t *testing.T) {
+		_, err := client.FindAssetByJsonUri(context.Background(), "https://arweave.net/unknown.json")
+		apiErr, ok := IsAPIError(err)
+		if !ok || !apiErr.IsNotFound() {
+			t.Errorf("err = %v, want not-found APIError", err)
 		}
-		if len(assets) != 0 {
-			t.Errorf("len(assets) = %d, want 0", len(assets))
+	})
+
+	t.Run("empty uri", func(t *testing.T) {
+		_, err := client.FindAssetByJsonUri(context.Background(), "")
+		if valErr, ok := IsValidationError(err); !ok || valErr.Field != "jsonUri" {
+			t.Errorf("err = %v, want ValidationError for jsonUri", err)
 		}
 	})
 }
 
-func TestAssetTypes(t *testing.T) {
-	t.Run("authority type", func(t *testing.T) {
-		auth := Authority{
-			Address: "authority-address",
-			Scopes:  []string{"full"},
+func TestSearchAssets_Filters(t *testing.T) {
+	var req map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req = nil
+		json.NewDecoder(r.Body).Decode(&req)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(AssetsPage{Items: []Asset{}})
+	}))
+	defer server.Close()
+
+	compressible := false
+	supply := int64(1)
+	royaltyAmount := 500
+
+	client, _ := NewClient("test-key", WithAPIURL(server.URL))
+	_, err := client.SearchAssets(context.Background(), &SearchAssetsOptions{
+		Compressible:         &compressible,
+		Supply:               &supply,
+		SupplyMint:           "supply-mint",
+		RoyaltyTargetType:    "creators",
+		RoyaltyTarget:        "royalty-target",
+		RoyaltyAmount:        &royaltyAmount,
+		OwnerType:            "single",
+		SpecificationVersion: "v1",
+	})
+	if err != nil {
+		t.Fatalf("SearchAssets returned error: %v", err)
+	}
+
+	tests := []struct {
+		key  string
+		want interface{}
+	}{
+		{"compressible", false},
+		{"supply", float64(1)},
+		{"supplyMint", "supply-mint"},
+		{"royaltyTargetType", "creators"},
+		{"royaltyTarget", "royalty-target"},
+		{"royaltyAmount", float64(500)},
+		{"ownerType", "single"},
+		{"specificationVersion", "v1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			got, ok := req[tt.key]
+			if !ok {
+				t.Fatalf("%s missing from request body", tt.key)
+			}
+			if got != tt.want {
+				t.Errorf("%s = %v, want %v", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetAssetsByCreator(t *testing.T) {
+	var req map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/assets/search" {
+			t.Errorf("expected /assets/search, got %s", r.URL.Path)
 		}
-		if auth.Address != "authority-address" {
-			t.Errorf("Address = %s, unexpected value", auth.Address)
+		req = nil
+		json.NewDecoder(r.Body).Decode(&req)
+		json.NewEncoder(w).Encode(AssetsPage{Items: []Asset{}})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-key", WithAPIURL(server.URL))
+	verified, unverified := true, false
+
+	tests := []struct {
+		name         string
+		opts         *AssetsByCreatorOptions
+		wantVerified interface{}
+	}{
+		{name: "nil options", opts: nil, wantVerified: nil},
+		{name: "unset", opts: &AssetsByCreatorOptions{}, wantVerified: nil},
+		{name: "verified only", opts: &AssetsByCreatorOptions{OnlyVerified: &verified}, wantVerified: true},
+		{name: "unverified only", opts: &AssetsByCreatorOptions{OnlyVerified: &unverified}, wantVerified: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := client.GetAssetsByCreator(context.Background(), "creator", tt.opts); err != nil {
+				t.Fatalf("GetAssetsByCreator returned error: %v", err)
+			}
+			if req["creatorAddress"] != "creator" {
+				t.Errorf("creatorAddress = %v, want creator", req["creatorAddress"])
+			}
+			got, ok := req["creatorVerified"]
+			if tt.wantVerified == nil {
+				if ok {
+					t.Errorf("creatorVerified = %v, want omitted", got)
+				}
+			} else if got != tt.wantVerified {
+				t.Errorf("creatorVerified = %v, want %v", got, tt.wantVerified)
+			}
+			if _, ok := req["onlyVerified"]; ok {
+				t.Error("onlyVerified should not be sent")
+			}
+		})
+	}
+
+	t.Run("with group", func(t *testing.T) {
+		_, err := client.GetAssetsByCreator(context.Background(), "creator", &AssetsByCreatorOptions{
+			OnlyVerified: &verified,
+			GroupKey:     "collection",
+			GroupValue:   "collection-mint",
+		})
+		if err != nil {
+			t.Fatalf("GetAssetsByCreator returned error: %v", err)
+		}
+		if req["groupKey"] != "collection" || req["groupValue"] != "collection-mint" || req["creatorVerified"] != true {
+			t.Errorf("request = %v, want creator, group and creatorVerified", req)
 		}
 	})
 
-	t.Run("royalty type", func(t *testing.T) {
-		royalty := Royalty{
-			RoyaltyModel: "creators",
-			Percent:      5.5,
-			BasisPoints:  550,
-			Locked:       true,
-		}
-		if royalty.BasisPoints != 550 {
-			t.Errorf("BasisPoints = %d, want 550", royalty.BasisPoints)
+	t.Run("empty creator", func(t *testing.T) {
+		_, err := client.GetAssetsByCreator(context.Background(), "", nil)
+		if valErr, ok := IsValidationError(err); !ok || valErr.Field != "creatorAddress" {
+			t.Errorf("err = %v, want ValidationError for creatorAddress", err)
 		}
 	})
+}
 
-	t.Run("ownership type", func(t *testing.T) {
-		ownership := Ownership{
-			Owner:          "owner-address",
-			OwnershipModel: "single",
-			Frozen:         false,
+func TestGetAssetsByAuthority(t *testing.T) {
+	var req map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req = nil
+		json.NewDecoder(r.Body).Decode(&req)
+		json.NewEncoder(w).Encode(AssetsPage{Items: []Asset{}})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-key", WithAPIURL(server.URL))
+	unverified := false
+
+	t.Run("authority only", func(t *testing.T) {
+		if _, err := client.GetAssetsByAuthority(context.Background(), "authority", nil); err != nil {
+			t.Fatalf("GetAssetsByAuthority returned error: %v", err)
 		}
-		if ownership.Owner != "owner-address" {
-			t.Errorf("Owner = %s, unexpected value", ownership.Owner)
+		if req["authorityAddress"] != "authority" {
+			t.Errorf("authorityAddress = %v, want authority", req["authorityAddress"])
+		}
+		for _, key := range []string{"creatorAddress", "creatorVerified"} {
+			if _, ok := req[key]; ok {
+				t.Errorf("%s should be omitted", key)
+			}
 		}
 	})
 
-	t.Run("token info with price", func(t *testing.T) {
-		info := TokenInfo{
-			Symbol:   "BONK",
-			Decimals: 5,
-			PriceInfo: &Price{
-				PricePerToken: 0.00002,
-				Currency:      "USDC",
-			},
+	t.Run("with creator", func(t *testing.T) {
+		_, err := client.GetAssetsByAuthority(context.Background(), "authority", &AssetsByAuthorityOptions{
+			CreatorAddress: "creator",
+			OnlyVerified:   &unverified,
+		})
+		if err != nil {
+			t.Fatalf("GetAssetsByAuthority returned error: %v", err)
 		}
-		if info.PriceInfo.PricePerToken != 0.00002 {
-			t.Errorf("PricePerToken = %f, unexpected value", info.PriceInfo.PricePerToken)
+		if req["creatorAddress"] != "creator" || req["creatorVerified"] != false {
+			t.Errorf("request = %v, want creator with creatorVerified false", req)
+		}
+	})
+
+	t.Run("only verified without creator", func(t *testing.T) {
+		_, err := client.GetAssetsByAuthority(context.Background(), "authority", &AssetsByAuthorityOptions{
+			OnlyVerified: &unverified,
+		})
+		if valErr, ok := IsValidationError(err); !ok || valErr.Field != "onlyVerified" {
+			t.Errorf("err = %v, want ValidationError for onlyVerified", err)
 		}
 	})
 }
 
-func TestGrouping(t *testing.T) {
-	g := Grouping{
-		GroupKey:   "collection",
-		GroupValue: "collection-mint-address",
-	}
+func TestSearchAssets_TokenType(t *testing.T) {
+	var req map[string]interface{}
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		req = nil
+		json.NewDecoder(r.Body).Decode(&req)
+
+		json.NewEncoder(w).Encode(AssetsPage{Items: []Asset{
+			{ID: "token-1", Interface: "FungibleToken"},
+			{ID: "nft-1", Interface: "V1_NFT"},
+		}})
+	}))
+	defer server.Close()
+
+	t.Run("all reaches the server", func(t *testing.T) {
+		client, _ := NewClient("test-key", WithAPIURL(server.URL), WithStrictValidation(true))
+		page, err := client.SearchAssets(context.Background(), &SearchAssetsOptions{
+			OwnerAddress: "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v",
+			TokenType:    TokenTypeAll,
+		})
+		if err != nil {
+			t.Fatalf("SearchAssets returned error: %v", err)
+		}
+		if req["tokenType"] != "all" {
+			t.Errorf("tokenType = %v, want all", req["tokenType"])
+		}
+		if len(page.Items) != 2 {
+			t.Errorf("len(Items) = %d, want 2", len(page.Items))
+		}
+	})
+
+	t.Run("unknown value forwarded by default", func(t *testing.T) {
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		_, err := client.SearchAssets(context.Background(), &SearchAssetsOptions{TokenType: "semiFungible"})
+		if err != nil {
+			t.Fatalf("SearchAssets returned error: %v", err)
+		}
+		if req["tokenType"] != "semiFungible" {
+			t.Errorf("tokenType = %v, want semiFungible", req["tokenType"])
+		}
+	})
+
+	t.Run("unknown value rejected when strict", func(t *testing.T) {
+		calls = 0
+		client, _ := NewClient("test-key", WithAPIURL(server.URL), WithStrictValidation(true))
+		_, err := client.SearchAssets(context.Background(), &SearchAssetsOptions{TokenType: "semiFungible"})
+		if valErr, ok := IsValidationError(err); !ok || valErr.Field != "tokenType" {
+			t.Errorf("err = %v, want ValidationError for tokenType", err)
+		}
+		if calls != 0 {
+			t.Errorf("calls = %d, want 0", calls)
+		}
+	})
+}
+
+func TestSearchAssets_BeforeAfter(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       *SearchAssetsOptions
+		wantBefore interface{}
+		wantAfter  interface{}
+	}{
+		{"before", &SearchAssetsOptions{OwnerAddress: "owner", Before: "asset-before"}, "asset-before", nil},
+		{"after", &SearchAssetsOptions{OwnerAddress: "owner", After: "asset-after"}, nil, "asset-after"},
+		{"omitted when empty", &SearchAssetsOptions{OwnerAddress: "owner"}, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req map[string]interface{}
+				json.NewDecoder(r.Body).Decode(&req)
+				if req["before"] != tt.wantBefore {
+					t.Errorf("before = %v, want %v", req["before"], tt.wantBefore)
+				}
+				if req["after"] != tt.wantAfter {
+					t.Errorf("after = %v, want %v", req["after"], tt.wantAfter)
+				}
+
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(AssetsPage{Items: []Asset{}})
+			}))
+			defer server.Close()
+
+			client, _ := NewClient("test-key", WithAPIURL(server.URL))
+			if _, err := client.SearchAssets(context.Background(), tt.opts); err != nil {
+				t.Fatalf("SearchAssets returned error: %v", err)
+			}
+		})
+	}
+}
+
+func TestSearchAssets_DisplayOptions(t *testing.T) {
+	t.Run("display options set", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+
+			displayOpts, ok := req["displayOptions"].(map[string]interface{})
+			if !ok {
+				t.Fatal("displayOptions should be present")
+			}
+			if displayOpts["showFungible"] != true {
+				t.Errorf("showFungible = %v, want true", displayOpts["showFungible"])
+			}
+			if displayOpts["showCollectionMetadata"] != true {
+				t.Errorf("showCollectionMetadata = %v, want true", displayOpts["showCollectionMetadata"])
+			}
+			if _, ok := req["showFungible"]; ok {
+				t.Error("showFungible should only be sent inside displayOptions")
+			}
+			if req["authorityAddress"] != "authority" {
+				t.Errorf("authorityAddress = %v, want authority", req["authorityAddress"])
+			}
+			if req["delegate"] != "delegate" {
+				t.Errorf("delegate = %v, want delegate", req["delegate"])
+			}
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(AssetsPage{Items: []Asset{}})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		_, err := client.SearchAssets(context.Background(), &SearchAssetsOptions{
+			OwnerAddress:           "owner",
+			AuthorityAddress:       "authority",
+			Delegate:               "delegate",
+			ShowFungible:           true,
+			ShowCollectionMetadata: true,
+		})
+		if err != nil {
+			t.Fatalf("SearchAssets returned error: %v", err)
+		}
+	})
+
+	t.Run("no display options", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+			if _, ok := req["displayOptions"]; ok {
+				t.Error("displayOptions should be omitted when no display option is set")
+			}
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(AssetsPage{Items: []Asset{}})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		_, err := client.SearchAssets(context.Background(), &SearchAssetsOptions{OwnerAddress: "owner"})
+		if err != nil {
+			t.Fatalf("SearchAssets returned error: %v", err)
+		}
+	})
+}
+
+func TestGetAssetBatch(t *testing.T) {
+	t.Run("successful batch", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/assets/batch" {
+				t.Errorf("expected /assets/batch, got %s", r.URL.Path)
+			}
+
+			var req map[string][]string
+			json.NewDecoder(r.Body).Decode(&req)
+			if len(req["ids"]) != 3 {
+				t.Errorf("len(ids) = %d, want 3", len(req["ids"]))
+			}
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode([]Asset{
+				{ID: "asset-1"},
+				{ID: "asset-2"},
+				{ID: "asset-3"},
+			})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		assets, err := client.GetAssetBatch(context.Background(), []string{"asset-1", "asset-2", "asset-3"})
+
+		if err != nil {
+			t.Fatalf("GetAssetBatch returned error: %v", err)
+		}
+		if len(assets) != 3 {
+			t.Errorf("len(assets) = %d, want 3", len(assets))
+		}
+	})
+
+	t.Run("duplicate ids", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req map[string][]string
+			json.NewDecoder(r.Body).Decode(&req)
+			if want := []string{"a", "b"}; !reflect.DeepEqual(req["ids"], want) {
+				t.Errorf("ids = %v, want %v", req["ids"], want)
+			}
+
+			var assets []Asset
+			for _, id := range req["ids"] {
+				assets = append(assets, Asset{ID: id})
+			}
+			json.NewEncoder(w).Encode(assets)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		assets, err := client.GetAssetBatch(context.Background(), []string{"a", "b", "a"})
+		if err != nil {
+			t.Fatalf("GetAssetBatch returned error: %v", err)
+		}
+		if len(assets) != 2 || assets[0].ID != "a" || assets[1].ID != "b" {
+			t.Errorf("assets = %+v, want [a b]", assets)
+		}
+
+		aligned, err := client.GetAssets(context.Background(), []string{"a", "b", "a"}, nil)
+		if err != nil {
+			t.Fatalf("GetAssets returned error: %v", err)
+		}
+		if len(aligned) != 3 || aligned[0].ID != "a" || aligned[1].ID != "b" || aligned[2].ID != "a" {
+			t.Errorf("GetAssets = %+v, want [a b a]", aligned)
+		}
+	})
+
+	t.Run("missing assets are nil", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`[{"id":"asset-1"},null,{"id":"asset-3"}]`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		assets, err := client.GetAssetBatch(context.Background(), []string{"asset-1", "missing", "asset-3"})
+		if err != nil {
+			t.Fatalf("GetAssetBatch returned error: %v", err)
+		}
+		if len(assets) != 3 {
+			t.Fatalf("len(assets) = %d, want 3", len(assets))
+		}
+		if assets[0] == nil || assets[0].ID != "asset-1" {
+			t.Errorf("assets[0] = %+v, want asset-1", assets[0])
+		}
+		if assets[1] != nil {
+			t.Errorf("assets[1] = %+v, want nil", assets[1])
+		}
+		if assets[2] == nil || assets[2].ID != "asset-3" {
+			t.Errorf("assets[2] = %+v, want asset-3", assets[2])
+		}
+
+		byID, err := client.GetAssetBatchMap(context.Background(), []string{"asset-1", "missing", "asset-3"})
+		if err != nil {
+			t.Fatalf("GetAssetBatchMap returned error: %v", err)
+		}
+		if len(byID) != 3 {
+			t.Fatalf("len(byID) = %d, want 3", len(byID))
+		}
+		if a, ok := byID["missing"]; !ok || a != nil {
+			t.Errorf("byID[missing] = %+v, %v, want nil, true", a, ok)
+		}
+		for _, id := range []string{"asset-1", "asset-3"} {
+			if byID[id] == nil || byID[id].ID != id {
+				t.Errorf("byID[%s] = %+v, want %s", id, byID[id], id)
+			}
+		}
+	})
+
+	t.Run("empty ids", func(t *testing.T) {
+		client, _ := NewClient("test-key")
+		assets, err := client.GetAssetBatch(context.Background(), []string{})
+
+		if err != nil {
+			t.Fatalf("GetAssetBatch returned error: %v", err)
+		}
+		if len(assets) != 0 {
+			t.Errorf("len(assets) = %d, want 0", len(assets))
+		}
+	})
+}
+
+func TestAssetTypes(t *testing.T) {
+	t.Run("authority type", func(t *testing.T) {
+		auth := Authority{
+			Address: "authority-address",
+			Scopes:  []string{"full"},
+		}
+		if auth.Address != "authority-address" {
+			t.Errorf("Address = %s, unexpected value", auth.Address)
+		}
+	})
+
+	t.Run("royalty type", func(t *testing.T) {
+		royalty := Royalty{
+			RoyaltyModel: "creators",
+			Percent:      5.5,
+			BasisPoints:  550,
+			Locked:       true,
+		}
+		if royalty.BasisPoints != 550 {
+			t.Errorf("BasisPoints = %d, want 550", royalty.BasisPoints)
+		}
+	})
+
+	t.Run("ownership type", func(t *testing.T) {
+		ownership := Ownership{
+			Owner:          "owner-address",
+			OwnershipModel: "single",
+			Frozen:         false,
+		}
+		if ownership.Owner != "owner-address" {
+			t.Errorf("Owner = %s, unexpected value", ownership.Owner)
+		}
+	})
+
+	t.Run("token info with price", func(t *testing.T) {
+		info := TokenInfo{
+			Symbol:   "BONK",
+			Decimals: 5,
+			PriceInfo: &Price{
+				PricePerToken: 0.00002,
+				Currency:      "USDC",
+			},
+		}
+		if info.PriceInfo.PricePerToken != 0.00002 {
+			t.Errorf("PricePerToken = %f, unexpected value", info.PriceInfo.PricePerToken)
+		}
+	})
+}
+
+func TestGrouping(t *testing.T) {
+	g := Grouping{
+		GroupKey:   "collection",
+		GroupValue: "collection-mint-address",
+	}
 	if g.GroupKey != "collection" {
 		t.Errorf("GroupKey = %s, want collection", g.GroupKey)
 	}
@@ -473,4 +1281,1023 @@ func TestSortBy(t *testing.T) {
 	if sort.SortBy != "created" {
 		t.Errorf("SortBy = %s, want created", sort.SortBy)
 	}
+
+	t.Run("constants", func(t *testing.T) {
+		tests := []struct {
+			got  string
+			want string
+		}{
+			{string(SortByCreated), "created"},
+			{string(SortByUpdated), "updated"},
+			{string(SortByRecentAction), "recent_action"},
+			{string(SortByNone), "none"},
+			{string(SortAsc), "asc"},
+			{string(SortDesc), "desc"},
+		}
+		for _, tt := range tests {
+			if tt.got != tt.want {
+				t.Errorf("constant = %q, want %q", tt.got, tt.want)
+			}
+		}
+	})
+
+	t.Run("NewSortBy", func(t *testing.T) {
+		got := NewSortBy(SortByRecentAction, SortAsc)
+		if *got != (SortBy{SortBy: "recent_action", SortDirection: "asc"}) {
+			t.Errorf("NewSortBy() = %+v, want recent_action asc", got)
+		}
+		data, _ := json.Marshal(got)
+		if string(data) != `{"sortBy":"recent_action","sortDirection":"asc"}` {
+			t.Errorf("json = %s, unexpected", data)
+		}
+	})
+
+	t.Run("strict validation", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"items":[]}`))
+		}))
+		defer server.Close()
+
+		strict, _ := NewClient("test-key", WithAPIURL(server.URL), WithStrictValidation(true))
+		lenient, _ := NewClient("test-key", WithAPIURL(server.URL))
+
+		tests := []struct {
+			name  string
+			sort  *SortBy
+			field string
+		}{
+			{"valid", NewSortBy(SortByCreated, SortDesc), ""},
+			{"bad direction", &SortBy{SortBy: "created", SortDirection: "descending"}, "sortBy.sortDirection"},
+			{"bad field", &SortBy{SortBy: "name", SortDirection: "asc"}, "sortBy.sortBy"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := strict.SearchAssets(context.Background(), &SearchAssetsOptions{OwnerAddress: "owner", SortBy: tt.sort})
+				if tt.field == "" {
+					if err != nil {
+						t.Errorf("SearchAssets returned error: %v", err)
+					}
+				} else if valErr, ok := IsValidationError(err); !ok || valErr.Field != tt.field {
+					t.Errorf("err = %v, want ValidationError for %s", err, tt.field)
+				}
+
+				if _, err := lenient.SearchAssets(context.Background(), &SearchAssetsOptions{OwnerAddress: "owner", SortBy: tt.sort}); err != nil {
+					t.Errorf("non-strict SearchAssets returned error: %v", err)
+				}
+			})
+		}
+	})
+}
+
+func TestAssetInterfaceAndTokenTypeConstants(t *testing.T) {
+	interfaces := []struct {
+		iface AssetInterface
+		want  string
+	}{
+		{InterfaceV1NFT, "V1_NFT"},
+		{InterfaceV1Print, "V1_PRINT"},
+		{InterfaceLegacyNFT, "LEGACY_NFT"},
+		{InterfaceV2NFT, "V2_NFT"},
+		{InterfaceProgrammableNFT, "ProgrammableNFT"},
+		{InterfaceFungibleToken, "FungibleToken"},
+		{InterfaceFungibleAsset, "FungibleAsset"},
+		{InterfaceMplCoreAsset, "MplCoreAsset"},
+		{InterfaceMplCoreCollection, "MplCoreCollection"},
+		{InterfaceCustom, "Custom"},
+		{InterfaceIdentity, "Identity"},
+		{InterfaceExecutable, "Executable"},
+	}
+	for _, tt := range interfaces {
+		if string(tt.iface) != tt.want {
+			t.Errorf("AssetInterface = %q, want %q", tt.iface, tt.want)
+		}
+	}
+
+	tokenTypes := []struct {
+		tokenType TokenType
+		want      string
+	}{
+		{TokenTypeFungible, "fungible"},
+		{TokenTypeNonFungible, "nonFungible"},
+		{TokenTypeRegularNFT, "regularNft"},
+		{TokenTypeCompressedNFT, "compressedNft"},
+		{TokenTypeAll, "all"},
+	}
+	for _, tt := range tokenTypes {
+		if string(tt.tokenType) != tt.want {
+			t.Errorf("TokenType = %q, want %q", tt.tokenType, tt.want)
+		}
+	}
+}
+
+func TestAsset_InterfaceType(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want AssetInterface
+	}{
+		{"V1_NFT", InterfaceV1NFT},
+		{"V1_PRINT", InterfaceV1Print},
+		{"LEGACY_NFT", InterfaceLegacyNFT},
+		{"V2_NFT", InterfaceV2NFT},
+		{"ProgrammableNFT", InterfaceProgrammableNFT},
+		{"FungibleToken", InterfaceFungibleToken},
+		{"FungibleAsset", InterfaceFungibleAsset},
+		{"MplCoreAsset", InterfaceMplCoreAsset},
+		{"MplCoreCollection", InterfaceMplCoreCollection},
+		{"Custom", InterfaceCustom},
+		{"Identity", InterfaceIdentity},
+		{"Executable", InterfaceExecutable},
+		{"V3_NFT", InterfaceUnknown},
+		{"Unknown", InterfaceUnknown},
+		{"", InterfaceUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			asset := &Asset{Interface: tt.raw}
+			if got := asset.InterfaceType(); got != tt.want {
+				t.Errorf("InterfaceType() = %q, want %q", got, tt.want)
+			}
+			if asset.Interface != tt.raw {
+				t.Errorf("Interface = %q, want raw value %q kept", asset.Interface, tt.raw)
+			}
+		})
+	}
+}
+
+func TestAsset_MetadataHelpers(t *testing.T) {
+	t.Run("full content", func(t *testing.T) {
+		asset := &Asset{
+			Content: &AssetContent{
+				Metadata: map[string]interface{}{
+					"name":        "Mad Lad #1",
+					"description": "A mad lad",
+				},
+				Links: map[string]string{"image": "https://example.com/link.png"},
+				Files: []AssetFile{
+					{URI: "https://example.com/file.png", Mime: "image/png"},
+				},
+			},
+			Grouping: []Grouping{
+				{GroupKey: "collection", GroupValue: "collection-mint"},
+			},
+		}
+
+		if got := asset.Name(); got != "Mad Lad #1" {
+			t.Errorf("Name() = %q, want %q", got, "Mad Lad #1")
+		}
+		if got := asset.Description(); got != "A mad lad" {
+			t.Errorf("Description() = %q, want %q", got, "A mad lad")
+		}
+		if got := asset.ImageURL(); got != "https://example.com/link.png" {
+			t.Errorf("ImageURL() = %q, want link image", got)
+		}
+		if got := asset.CollectionAddress(); got != "collection-mint" {
+			t.Errorf("CollectionAddress() = %q, want %q", got, "collection-mint")
+		}
+	})
+
+	t.Run("partial content", func(t *testing.T) {
+		asset := &Asset{
+			Content: &AssetContent{
+				Metadata: map[string]interface{}{
+					"name":        42, // wrong type
+					"description": nil,
+				},
+				Files: []AssetFile{
+					{URI: "https://example.com/anim.mp4", Mime: "video/mp4"},
+					{URI: "https://example.com/file.jpg", Mime: "image/jpeg"},
+				},
+			},
+			Grouping: []Grouping{
+				{GroupKey: "other", GroupValue: "value"},
+			},
+		}
+
+		if got := asset.Name(); got != "" {
+			t.Errorf("Name() = %q, want empty", got)
+		}
+		if got := asset.Description(); got != "" {
+			t.Errorf("Description() = %q, want empty", got)
+		}
+		if got := asset.ImageURL(); got != "https://example.com/file.jpg" {
+			t.Errorf("ImageURL() = %q, want first image file", got)
+		}
+		if got := asset.CollectionAddress(); got != "" {
+			t.Errorf("CollectionAddress() = %q, want empty", got)
+		}
+	})
+
+	t.Run("nil content", func(t *testing.T) {
+		asset := &Asset{}
+
+		if got := asset.Name(); got != "" {
+			t.Errorf("Name() = %q, want empty", got)
+		}
+		if got := asset.Description(); got != "" {
+			t.Errorf("Description() = %q, want empty", got)
+		}
+		if got := asset.ImageURL(); got != "" {
+			t.Errorf("ImageURL() = %q, want empty", got)
+		}
+		if got := asset.CollectionAddress(); got != "" {
+			t.Errorf("CollectionAddress() = %q, want empty", got)
+		}
+	})
+}
+
+func TestAsset_Predicates(t *testing.T) {
+	tests := []struct {
+		iface        string
+		wantFungible bool
+		wantNFT      bool
+	}{
+		{"V1_NFT", false, true},
+		{"V2_NFT", false, true},
+		{"V1_PRINT", false, true},
+		{"LEGACY_NFT", false, true},
+		{"ProgrammableNFT", false, true},
+		{"MplCoreAsset", false, true},
+		{"FungibleToken", true, false},
+		{"FungibleAsset", true, false},
+		{"MplCoreCollection", false, false},
+		{"Custom", false, false},
+		{"Identity", false, false},
+		{"Executable", false, false},
+		{"", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.iface, func(t *testing.T) {
+			asset := &Asset{Interface: tt.iface}
+			if got := asset.IsFungible(); got != tt.wantFungible {
+				t.Errorf("IsFungible() = %v, want %v", got, tt.wantFungible)
+			}
+			if got := asset.IsNFT(); got != tt.wantNFT {
+				t.Errorf("IsNFT() = %v, want %v", got, tt.wantNFT)
+			}
+		})
+	}
+
+	t.Run("IsCompressed", func(t *testing.T) {
+		if (&Asset{}).IsCompressed() {
+			t.Error("IsCompressed() should be false for nil Compression")
+		}
+		if (&Asset{Compression: &Compression{Eligible: true}}).IsCompressed() {
+			t.Error("IsCompressed() should be false when not compressed")
+		}
+		if !(&Asset{Compression: &Compression{Compressed: true}}).IsCompressed() {
+			t.Error("IsCompressed() should be true when compressed")
+		}
+	})
+}
+
+func TestAsset_Programmable(t *testing.T) {
+	t.Run("pNFT response", func(t *testing.T) {
+		fixture := []byte(`{
+			"interface": "ProgrammableNFT",
+			"id": "pnft-mint",
+			"content": {
+				"metadata": {
+					"name": "pNFT #1",
+					"token_standard": "ProgrammableNonFungible"
+				}
+			},
+			"ownership": {
+				"frozen": true,
+				"delegated": false,
+				"ownership_model": "single",
+				"owner": "owner-address",
+				"programmable_config": {
+					"rule_set": "eBJLFYPxJmMGKuFwpDWkzxZeUrad92kZRC5BJLpzyT9"
+				}
+			},
+			"token_standard": "ProgrammableNonFungible",
+			"mutable": true,
+			"burnt": false
+		}`)
+
+		var asset Asset
+		if err := json.Unmarshal(fixture, &asset); err != nil {
+			t.Fatalf("Unmarshal returned error: %v", err)
+		}
+		if asset.TokenStandard != "ProgrammableNonFungible" {
+			t.Errorf("TokenStandard = %s, want ProgrammableNonFungible", asset.TokenStandard)
+		}
+		if asset.Ownership.ProgrammableConfig == nil {
+			t.Fatal("ProgrammableConfig should not be nil")
+		}
+		if asset.Ownership.ProgrammableConfig.RuleSet != "eBJLFYPxJmMGKuFwpDWkzxZeUrad92kZRC5BJLpzyT9" {
+			t.Errorf("RuleSet = %s, unexpected value", asset.Ownership.ProgrammableConfig.RuleSet)
+		}
+		if !asset.IsProgrammable() {
+			t.Error("IsProgrammable() should be true")
+		}
+	})
+
+	t.Run("token standard in metadata only", func(t *testing.T) {
+		asset := &Asset{
+			Interface: "V1_NFT",
+			Content: &AssetContent{
+				Metadata: map[string]interface{}{"token_standard": "ProgrammableNonFungible"},
+			},
+		}
+		if !asset.IsProgrammable() {
+			t.Error("IsProgrammable() should be true")
+		}
+	})
+
+	t.Run("regular NFT", func(t *testing.T) {
+		fixture := []byte(`{
+			"interface": "V1_NFT",
+			"id": "nft-mint",
+			"ownership": {"owner": "owner-address", "ownership_model": "single"}
+		}`)
+
+		var asset Asset
+		if err := json.Unmarshal(fixture, &asset); err != nil {
+			t.Fatalf("Unmarshal returned error: %v", err)
+		}
+		if asset.TokenStandard != "" {
+			t.Errorf("TokenStandard = %s, want empty", asset.TokenStandard)
+		}
+		if asset.Ownership.ProgrammableConfig != nil {
+			t.Error("ProgrammableConfig should be nil")
+		}
+		if asset.IsProgrammable() {
+			t.Error("IsProgrammable() should be false")
+		}
+	})
+}
+
+func TestGetAssets(t *testing.T) {
+	t.Run("mixed existing and missing ids", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/assets/batch" {
+				t.Errorf("expected /assets/batch, got %s", r.URL.Path)
+			}
+
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+			displayOpts, _ := req["displayOptions"].(map[string]interface{})
+			if displayOpts["showFungible"] != true {
+				t.Errorf("showFungible = %v, want true", displayOpts["showFungible"])
+			}
+
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`[{"id":"asset-1"},null,{"id":"asset-3"}]`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		assets, err := client.GetAssets(context.Background(),
+			[]string{"asset-1", "missing", "asset-3"},
+			&GetAssetOptions{ShowFungible: true},
+		)
+		if err != nil {
+			t.Fatalf("GetAssets returned error: %v", err)
+		}
+		if len(assets) != 3 {
+			t.Fatalf("len(assets) = %d, want 3", len(assets))
+		}
+		if assets[0].ID != "asset-1" {
+			t.Errorf("assets[0].ID = %s, want asset-1", assets[0].ID)
+		}
+		if assets[1].ID != "" {
+			t.Errorf("assets[1].ID = %s, want empty for missing asset", assets[1].ID)
+		}
+		if assets[2].ID != "asset-3" {
+			t.Errorf("assets[2].ID = %s, want asset-3", assets[2].ID)
+		}
+	})
+
+	t.Run("chunks large requests", func(t *testing.T) {
+		var calls int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			var req struct {
+				IDs []string `json:"ids"`
+			}
+			json.NewDecoder(r.Body).Decode(&req)
+			if len(req.IDs) > maxAssetBatchSize {
+				t.Errorf("len(ids) = %d, exceeds %d", len(req.IDs), maxAssetBatchSize)
+			}
+
+			// Respond in reverse order to verify results are realigned.
+			assets := make([]Asset, len(req.IDs))
+			for i, id := range req.IDs {
+				assets[len(req.IDs)-1-i] = Asset{ID: id}
+			}
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(assets)
+		}))
+		defer server.Close()
+
+		ids := make([]string, 2500)
+		for i := range ids {
+			ids[i] = fmt.Sprintf("asset-%d", i)
+		}
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		assets, err := client.GetAssets(context.Background(), ids, nil)
+		if err != nil {
+			t.Fatalf("GetAssets returned error: %v", err)
+		}
+		if calls != 3 {
+			t.Errorf("calls = %d, want 3", calls)
+		}
+		if len(assets) != len(ids) {
+			t.Fatalf("len(assets) = %d, want %d", len(assets), len(ids))
+		}
+		for i, a := range assets {
+			if a.ID != ids[i] {
+				t.Fatalf("assets[%d].ID = %s, want %s", i, a.ID, ids[i])
+			}
+		}
+	})
+
+	t.Run("empty ids", func(t *testing.T) {
+		client, _ := NewClient("test-key")
+		assets, err := client.GetAssets(context.Background(), nil, nil)
+		if err != nil {
+			t.Fatalf("GetAssets returned error: %v", err)
+		}
+		if len(assets) != 0 {
+			t.Errorf("len(assets) = %d, want 0", len(assets))
+		}
+	})
+}
+
+func TestStreamAssetsByOwner(t *testing.T) {
+	pages := map[string]AssetsPage{
+		"":         {Cursor: "cursor-2", Items: []Asset{{ID: "asset-1"}, {ID: "asset-2"}}},
+		"cursor-2": {Cursor: "cursor-3", Items: []Asset{{ID: "asset-3"}, {ID: "asset-4"}}},
+		"cursor-3": {Items: []Asset{{ID: "asset-5"}}},
+	}
+
+	newServer := func() *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+			cursor, _ := req["cursor"].(string)
+
+			page, ok := pages[cursor]
+			if !ok {
+				t.Errorf("unexpected cursor: %s", cursor)
+			}
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(page)
+		}))
+	}
+
+	t.Run("full enumeration", func(t *testing.T) {
+		server := newServer()
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		assets, errs := client.StreamAssetsByOwner(context.Background(), "owner", &AssetsByOwnerOptions{Limit: 2})
+
+		var ids []string
+		for asset := range assets {
+			ids = append(ids, asset.ID)
+		}
+		if err := <-errs; err != nil {
+			t.Fatalf("StreamAssetsByOwner returned error: %v", err)
+		}
+		if len(ids) != 5 {
+			t.Fatalf("len(assets) = %d, want 5", len(ids))
+		}
+		for i, id := range ids {
+			if want := fmt.Sprintf("asset-%d", i+1); id != want {
+				t.Errorf("assets[%d] = %s, want %s", i, id, want)
+			}
+		}
+	})
+
+	t.Run("starting page is ignored", func(t *testing.T) {
+		server := newServer()
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		assets, errs := client.StreamAssetsByOwner(context.Background(), "owner", &AssetsByOwnerOptions{Page: 1, Limit: 2})
+
+		var n int
+		for range assets {
+			n++
+		}
+		if err := <-errs; err != nil {
+			t.Fatalf("StreamAssetsByOwner returned error: %v", err)
+		}
+		if n != 5 {
+			t.Errorf("len(assets) = %d, want 5", n)
+		}
+	})
+
+	t.Run("early cancellation", func(t *testing.T) {
+		server := newServer()
+		defer server.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		assets, errs := client.StreamAssetsByOwner(ctx, "owner", nil)
+
+		first := <-assets
+		if first.ID != "asset-1" {
+			t.Errorf("first asset = %s, want asset-1", first.ID)
+		}
+		cancel()
+
+		for range assets {
+		}
+		if err := <-errs; !errors.Is(err, context.Canceled) {
+			t.Errorf("err = %v, want context.Canceled", err)
+		}
+	})
+
+	t.Run("api error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		assets, errs := client.StreamAssetsByOwner(context.Background(), "owner", nil)
+
+		for range assets {
+		}
+		if _, ok := IsAPIError(<-errs); !ok {
+			t.Error("error should be APIError")
+		}
+	})
+
+	t.Run("max pages", func(t *testing.T) {
+		server := newServer()
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL), WithMaxPages(2))
+		assets, errs := client.StreamAssetsByOwner(context.Background(), "owner", nil)
+
+		var count int
+		for range assets {
+			count++
+		}
+		if count != 4 {
+			t.Errorf("count = %d, want 4 (two pages)", count)
+		}
+		if err := <-errs; !errors.Is(err, ErrMaxPagesExceeded) {
+			t.Errorf("err = %v, want ErrMaxPagesExceeded", err)
+		}
+	})
+}
+
+func TestGetAssetWithMetadata(t *testing.T) {
+	newAPIServer := func(jsonURI string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(Asset{
+				ID:      "nft-123",
+				Content: &AssetContent{JSONUri: jsonURI},
+			})
+		}))
+	}
+
+	t.Run("resolves metadata", func(t *testing.T) {
+		metaServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"name":"Old NFT","attributes":[{"trait_type":"hat","value":"red"}]}`))
+		}))
+		defer metaServer.Close()
+		apiServer := newAPIServer(metaServer.URL + "/metadata.json")
+		defer apiServer.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(apiServer.URL))
+		asset, metadata, err := client.GetAssetWithMetadata(context.Background(), "nft-123")
+		if err != nil {
+			t.Fatalf("GetAssetWithMetadata returned error: %v", err)
+		}
+		if asset.ID != "nft-123" {
+			t.Errorf("ID = %s, want nft-123", asset.ID)
+		}
+		if metadata["name"] != "Old NFT" {
+			t.Errorf("name = %v, want Old NFT", metadata["name"])
+		}
+	})
+
+	t.Run("metadata fetch fails", func(t *testing.T) {
+		metaServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer metaServer.Close()
+		apiServer := newAPIServer(metaServer.URL + "/missing.json")
+		defer apiServer.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(apiServer.URL))
+		asset, metadata, err := client.GetAssetWithMetadata(context.Background(), "nft-123")
+
+		var metaErr *MetadataError
+		if !errors.As(err, &metaErr) {
+			t.Fatalf("err = %v, want MetadataError", err)
+		}
+		if asset == nil || asset.ID != "nft-123" {
+			t.Error("asset should be returned when metadata fetch fails")
+		}
+		if metadata != nil {
+			t.Error("metadata should be nil when fetch fails")
+		}
+	})
+
+	t.Run("metadata too large", func(t *testing.T) {
+		metaServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"blob":"`))
+			w.Write(bytes.Repeat([]byte("a"), maxMetadataSize))
+			w.Write([]byte(`"}`))
+		}))
+		defer metaServer.Close()
+		apiServer := newAPIServer(metaServer.URL)
+		defer apiServer.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(apiServer.URL))
+		asset, _, err := client.GetAssetWithMetadata(context.Background(), "nft-123")
+		if _, ok := err.(*MetadataError); !ok {
+			t.Errorf("err = %v, want MetadataError", err)
+		}
+		if asset == nil {
+			t.Error("asset should be returned when metadata is too large")
+		}
+	})
+
+	t.Run("no json uri", func(t *testing.T) {
+		apiServer := newAPIServer("")
+		defer apiServer.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(apiServer.URL))
+		asset, metadata, err := client.GetAssetWithMetadata(context.Background(), "nft-123")
+		if err != nil {
+			t.Fatalf("GetAssetWithMetadata returned error: %v", err)
+		}
+		if asset == nil || metadata != nil {
+			t.Error("expected asset with nil metadata")
+		}
+	})
+
+	t.Run("asset fetch fails", func(t *testing.T) {
+		client, _ := NewClient("test-key")
+		_, _, err := client.GetAssetWithMetadata(context.Background(), "")
+		if _, ok := IsValidationError(err); !ok {
+			t.Errorf("err = %v, want ValidationError", err)
+		}
+	})
+}
+
+func TestAssetsPage_Pagination(t *testing.T) {
+	tests := []struct {
+		name          string
+		page          AssetsPage
+		wantPages     int
+		wantNextPages bool
+	}{
+		{"exact multiple", AssetsPage{Total: 100, Limit: 50, Page: 1}, 2, true},
+		{"exact multiple last page", AssetsPage{Total: 100, Limit: 50, Page: 2}, 2, false},
+		{"remainder", AssetsPage{Total: 101, Limit: 50, Page: 2}, 3, true},
+		{"remainder last page", AssetsPage{Total: 101, Limit: 50, Page: 3}, 3, false},
+		{"empty", AssetsPage{Total: 0, Limit: 50, Page: 1}, 0, false},
+		{"zero limit", AssetsPage{Total: 10}, 0, false},
+		{"cursor", AssetsPage{Total: 10, Limit: 10, Cursor: "next"}, 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.page.TotalPages(); got != tt.wantPages {
+				t.Errorf("TotalPages() = %d, want %d", got, tt.wantPages)
+			}
+			if got := tt.page.HasNextPage(); got != tt.wantNextPages {
+				t.Errorf("HasNextPage() = %v, want %v", got, tt.wantNextPages)
+			}
+		})
+	}
+}
+
+func TestAssetsPage_GrandTotal(t *testing.T) {
+	t.Run("forwarded and decoded", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				DisplayOptions map[string]bool `json:"displayOptions"`
+			}
+			json.NewDecoder(r.Body).Decode(&req)
+			if !req.DisplayOptions["showGrandTotal"] {
+				t.Errorf("displayOptions = %v, want showGrandTotal", req.DisplayOptions)
+			}
+
+			w.Write([]byte(`{"total": 2, "limit": 2, "page": 1, "grand_total": 4821, "items": [{"id": "a"}, {"id": "b"}]}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		page, err := client.GetAssetsByOwner(context.Background(), "owner", &AssetsByOwnerOptions{
+			Limit:          2,
+			ShowGrandTotal: true,
+		})
+		if err != nil {
+			t.Fatalf("GetAssetsByOwner returned error: %v", err)
+		}
+		if page.Total != 2 || page.GrandTotal != 4821 {
+			t.Errorf("Total/GrandTotal = %d/%d, want 2/4821", page.Total, page.GrandTotal)
+		}
+		if len(page.Items) != 2 {
+			t.Errorf("len(Items) = %d, want 2", len(page.Items))
+		}
+	})
+
+	t.Run("decoding variants", func(t *testing.T) {
+		tests := []struct {
+			name string
+			body string
+			want int
+		}{
+			{"snake case", `{"total": 1, "grand_total": 10}`, 10},
+			{"camel case", `{"total": 1, "grandTotal": 20}`, 20},
+			{"absent", `{"total": 1}`, 0},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var page AssetsPage
+				if err := json.Unmarshal([]byte(tt.body), &page); err != nil {
+					t.Fatalf("unmarshal: %v", err)
+				}
+				if page.Total != 1 || page.GrandTotal != tt.want {
+					t.Errorf("Total/GrandTotal = %d/%d, want 1/%d", page.Total, page.GrandTotal, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("forwarded by GetAssetWithOptions", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				DisplayOptions map[string]bool `json:"displayOptions"`
+			}
+			json.NewDecoder(r.Body).Decode(&req)
+			if !req.DisplayOptions["showGrandTotal"] {
+				t.Errorf("displayOptions = %v, want showGrandTotal", req.DisplayOptions)
+			}
+			w.Write([]byte(`{"id": "asset-1"}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		if _, err := client.GetAssetWithOptions(context.Background(), "asset-1", &GetAssetOptions{ShowGrandTotal: true}); err != nil {
+			t.Fatalf("GetAssetWithOptions returned error: %v", err)
+		}
+	})
+}
+
+func TestGetAssetWithOptions_Inscription(t *testing.T) {
+	t.Run("inscribed asset", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+			displayOpts, _ := req["displayOptions"].(map[string]interface{})
+			if displayOpts["showInscription"] != true {
+				t.Errorf("showInscription = %v, want true", displayOpts["showInscription"])
+			}
+
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{
+				"interface": "V1_NFT",
+				"id": "inscribed-mint",
+				"inscription": {
+					"order": 308243,
+					"size": 67,
+					"contentType": "application/text",
+					"encoding": "base64",
+					"validationHash": "f7a4c2b1",
+					"inscriptionDataAccount": "data-account",
+					"authority": "authority-address"
+				},
+				"spl20": {"p": "spl-20", "op": "mint", "tick": "helius", "amt": "1000"}
+			}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		asset, err := client.GetAssetWithOptions(context.Background(), "inscribed-mint", &GetAssetOptions{ShowInscription: true})
+		if err != nil {
+			t.Fatalf("GetAssetWithOptions returned error: %v", err)
+		}
+		if asset.Inscription == nil {
+			t.Fatal("Inscription should not be nil")
+		}
+		if asset.Inscription.Order != 308243 {
+			t.Errorf("Order = %d, want 308243", asset.Inscription.Order)
+		}
+		if asset.Inscription.Size != 67 {
+			t.Errorf("Size = %d, want 67", asset.Inscription.Size)
+		}
+		if asset.Inscription.ContentType != "application/text" {
+			t.Errorf("ContentType = %s, want application/text", asset.Inscription.ContentType)
+		}
+		if asset.Inscription.InscriptionDataAccount != "data-account" {
+			t.Errorf("InscriptionDataAccount = %s, want data-account", asset.Inscription.InscriptionDataAccount)
+		}
+		if asset.Spl20["tick"] != "helius" {
+			t.Errorf("Spl20[tick] = %v, want helius", asset.Spl20["tick"])
+		}
+	})
+
+	t.Run("non-inscribed asset", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+			if _, ok := req["displayOptions"]; ok {
+				t.Error("displayOptions should be omitted without options")
+			}
+
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"interface": "V1_NFT", "id": "plain-mint"}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		asset, err := client.GetAsset(context.Background(), "plain-mint")
+		if err != nil {
+			t.Fatalf("GetAsset returned error: %v", err)
+		}
+		if asset.Inscription != nil {
+			t.Error("Inscription should be nil")
+		}
+		if asset.Spl20 != nil {
+			t.Error("Spl20 should be nil")
+		}
+	})
+}
+
+func TestAssetsPage_GroupByCollection(t *testing.T) {
+	page := &AssetsPage{
+		Items: []Asset{
+			{ID: "nft-1", Grouping: []Grouping{{GroupKey: "collection", GroupValue: "collection-a"}}},
+			{ID: "nft-2", Grouping: []Grouping{{GroupKey: "collection", GroupValue: "collection-b"}}},
+			{ID: "nft-3", Grouping: []Grouping{{GroupKey: "collection", GroupValue: "collection-a"}}},
+			{ID: "token-1", Interface: "FungibleToken"},
+		},
+	}
+
+	groups := page.GroupByCollection()
+
+	if len(groups) != 3 {
+		t.Fatalf("len(groups) = %d, want 3", len(groups))
+	}
+	if got := groups["collection-a"]; len(got) != 2 || got[0].ID != "nft-1" || got[1].ID != "nft-3" {
+		t.Errorf("collection-a = %v, want nft-1, nft-3", got)
+	}
+	if got := groups["collection-b"]; len(got) != 1 || got[0].ID != "nft-2" {
+		t.Errorf("collection-b = %v, want nft-2", got)
+	}
+	if got := groups[""]; len(got) != 1 || got[0].ID != "token-1" {
+		t.Errorf("ungrouped = %v, want token-1", got)
+	}
+}
+
+func TestAssetsPage_FrozenAndDelegated(t *testing.T) {
+	page := &AssetsPage{
+		Items: []Asset{
+			{ID: "normal", Ownership: &Ownership{Owner: "owner"}},
+			{ID: "frozen", Ownership: &Ownership{Frozen: true}},
+			{ID: "delegated", Ownership: &Ownership{Delegated: true, Delegate: "delegate"}},
+			{ID: "both", Ownership: &Ownership{Frozen: true, Delegated: true}},
+			{ID: "no-ownership"},
+		},
+	}
+
+	ids := func(assets []Asset) []string {
+		var out []string
+		for _, a := range assets {
+			out = append(out, a.ID)
+		}
+		return out
+	}
+
+	if got, want := ids(page.FilterFrozen()), []string{"frozen", "both"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterFrozen() = %v, want %v", got, want)
+	}
+	if got, want := ids(page.FilterDelegated()), []string{"delegated", "both"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterDelegated() = %v, want %v", got, want)
+	}
+
+	noOwnership := page.Items[4]
+	if noOwnership.IsFrozen() || noOwnership.IsDelegated() {
+		t.Error("asset without ownership should be neither frozen nor delegated")
+	}
+	if (&AssetsPage{}).FilterFrozen() != nil {
+		t.Error("FilterFrozen() on empty page should be nil")
+	}
+}
+
+func TestAssetsPage_Lightweight(t *testing.T) {
+	page := &AssetsPage{
+		Items: []Asset{
+			{ID: "nft-1", Interface: "V1_NFT", Ownership: &Ownership{Owner: "wallet-a"}, Content: &AssetContent{JSONUri: "https://example.com/1.json"}},
+			{ID: "token-1", Interface: "FungibleToken", Ownership: &Ownership{Owner: "wallet-b"}},
+			{ID: "no-ownership", Interface: "Custom"},
+		},
+	}
+
+	want := []AssetRef{
+		{ID: "nft-1", Interface: "V1_NFT", Owner: "wallet-a"},
+		{ID: "token-1", Interface: "FungibleToken", Owner: "wallet-b"},
+		{ID: "no-ownership", Interface: "Custom"},
+	}
+	if got := page.Lightweight(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Lightweight() = %+v, want %+v", got, want)
+	}
+
+	if got := (&AssetsPage{}).Lightweight(); len(got) != 0 {
+		t.Errorf("Lightweight() on empty page = %v, want empty", got)
+	}
+}
+
+func TestAsset_TokenAccountAndBalance(t *testing.T) {
+	t.Run("with token info", func(t *testing.T) {
+		var asset Asset
+		err := json.Unmarshal([]byte(`{"id":"token-1","interface":"FungibleToken","token_info":{"symbol":"USDC","balance":1500000,"decimals":6,"associated_token_address":"ata-1"}}`), &asset)
+		if err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		if ata, ok := asset.AssociatedTokenAccount(); !ok || ata != "ata-1" {
+			t.Errorf("AssociatedTokenAccount() = %q, %v, want ata-1, true", ata, ok)
+		}
+		if raw, decimals, ok := asset.Balance(); !ok || raw != 1_500_000 || decimals != 6 {
+			t.Errorf("Balance() = %d, %d, %v, want 1500000, 6, true", raw, decimals, ok)
+		}
+	})
+
+	t.Run("token info without account", func(t *testing.T) {
+		asset := Asset{TokenInfo: &TokenInfo{Symbol: "USDC", Decimals: 6}}
+		if ata, ok := asset.AssociatedTokenAccount(); ok {
+			t.Errorf("AssociatedTokenAccount() = %q, true, want false", ata)
+		}
+		if raw, decimals, ok := asset.Balance(); !ok || raw != 0 || decimals != 6 {
+			t.Errorf("Balance() = %d, %d, %v, want 0, 6, true", raw, decimals, ok)
+		}
+	})
+
+	t.Run("without token info", func(t *testing.T) {
+		asset := Asset{ID: "nft-1"}
+		if _, ok := asset.AssociatedTokenAccount(); ok {
+			t.Error("AssociatedTokenAccount() should be false without token info")
+		}
+		if _, _, ok := asset.Balance(); ok {
+			t.Error("Balance() should be false without token info")
+		}
+	})
+}
+
+func TestAsset_PriceUSD(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&req)
+
+		display, _ := req["displayOptions"].(map[string]interface{})
+		if display["showFungible"] == true {
+			w.Write([]byte(`{"id":"token-1","interface":"FungibleToken","token_info":{"symbol":"JUP","decimals":6,"price_info":{"price_per_token":0.8125,"currency":"USDC"}}}`))
+			return
+		}
+		w.Write([]byte(`{"id":"token-1","interface":"FungibleToken","token_info":{"symbol":"JUP","decimals":6}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-key", WithAPIURL(server.URL))
+
+	t.Run("with price info", func(t *testing.T) {
+		asset, err := client.GetAssetWithOptions(context.Background(), "token-1", &GetAssetOptions{ShowFungible: true})
+		if err != nil {
+			t.Fatalf("GetAssetWithOptions returned error: %v", err)
+		}
+		price, ok := asset.PriceUSD()
+		if !ok || price != 0.8125 {
+			t.Errorf("PriceUSD() = %v, %v, want 0.8125, true", price, ok)
+		}
+	})
+
+	t.Run("without price info", func(t *testing.T) {
+		asset, err := client.GetAsset(context.Background(), "token-1")
+		if err != nil {
+			t.Fatalf("GetAsset returned error: %v", err)
+		}
+		if price, ok := asset.PriceUSD(); ok {
+			t.Errorf("PriceUSD() = %v, true, want false", price)
+		}
+		if _, ok := (&Asset{}).PriceUSD(); ok {
+			t.Error("PriceUSD() on asset without token info should be false")
+		}
+	})
+
+	t.Run("other currency", func(t *testing.T) {
+		asset := &Asset{TokenInfo: &TokenInfo{PriceInfo: &Price{PricePerToken: 1, Currency: "EUR"}}}
+		if _, ok := asset.PriceUSD(); ok {
+			t.Error("PriceUSD() should be false for a non-USD quote")
+		}
+	})
 }
@@ -63,4 +63,7 @@
 //	        }
 //	    }
 //	}
+//
+// Invalid arguments are rejected before any request is made and returned as
+// *ValidationError; use IsValidationError to detect them.
 package helius
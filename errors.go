@@ -1,9 +1,54 @@
 package helius
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrMissingAPIKey is returned by NewClient when no API key is provided.
+var ErrMissingAPIKey = errors.New("helius: API key is required")
+
+// ErrInvalidAPIKey is returned by Ping when the API rejects the key.
+var ErrInvalidAPIKey = errors.New("helius: invalid API key")
+
+// ErrMaxPagesExceeded is returned by auto-paginating methods that stop
+// because the limit set with WithMaxPages was reached while more pages
+// remained. Results fetched up to that point are still returned where the
+// method supports partial results.
+var ErrMaxPagesExceeded = errors.New("helius: maximum number of pages exceeded")
+
+// ErrNotCompressed is returned by Asset.DecodeCompression for an asset that
+// is not a compressed NFT.
+var ErrNotCompressed = errors.New("helius: asset is not compressed")
+
+// ErrIteratorDone is returned by Paginator.Next when there are no more items.
+var ErrIteratorDone = errors.New("helius: no more items in iterator")
+
+// Sentinel errors matched by APIError according to its status code, so that
+// errors.Is(err, ErrNotFound) works on any error wrapping an *APIError.
+var (
+	// ErrNotFound matches 404 Not Found responses.
+	ErrNotFound = errors.New("helius: not found")
+	// ErrRateLimited matches 429 Too Many Requests responses.
+	ErrRateLimited = errors.New("helius: rate limited")
+	// ErrUnauthorized matches 401 Unauthorized responses.
+	ErrUnauthorized = errors.New("helius: unauthorized")
+	// ErrForbidden matches 403 Forbidden responses.
+	ErrForbidden = errors.New("helius: forbidden")
+	// ErrServerError matches 5xx responses.
+	ErrServerError = errors.New("helius: server error")
 )
 
 // APIError represents an error returned by the Helius API.
@@ -16,11 +61,105 @@ type APIError struct {
 
 	// Path is the API endpoint that returned the error.
 	Path string
+
+	// RetryAfter is the wait requested by the response's Retry-After header,
+	// or zero if it had none.
+	RetryAfter time.Duration
+
+	// RPCCode is the code of a JSON-RPC error object returned in the body of
+	// a successful (200) response, or zero for HTTP errors.
+	RPCCode int
 }
 
-// Error implements the error interface.
+// Error implements the error interface. It is the same as SafeError.
 func (e *APIError) Error() string {
-	return fmt.Sprintf("helius api error: %s returned status %d: %s", e.Path, e.StatusCode, e.Message)
+	return e.SafeError()
+}
+
+// SafeError returns the error text with any query string dropped from Path
+// and any api-key parameter in Message redacted, so it can be logged without
+// leaking the API key.
+func (e *APIError) SafeError() string {
+	path := e.Path
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	if e.RPCCode != 0 {
+		return fmt.Sprintf("helius api error: %s returned rpc error %d: %s", path, e.RPCCode, redactAPIKeyParams(e.Message))
+	}
+	return fmt.Sprintf("helius api error: %s returned status %d: %s", path, e.StatusCode, redactAPIKeyParams(e.Message))
+}
+
+// rpcErrorFromBody returns an *APIError for a JSON-RPC error object in the
+// top-level "error" field of body, which some RPC-style endpoints return
+// with status 200. It returns nil if body has no such object, leaving other
+// malformed bodies to be reported when decoding the result.
+//
+// It is only used for RPC-style endpoints: DAS responses may legitimately
+// carry an "error" field.
+func rpcErrorFromBody(path string, statusCode int, body []byte) error {
+	var envelope struct {
+		Error json.RawMessage `json:"error"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil
+	}
+	raw := bytes.TrimSpace(envelope.Error)
+	if len(raw) == 0 || raw[0] != '{' {
+		return nil
+	}
+
+	var rpcErr struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(raw, &rpcErr); err != nil {
+		return nil
+	}
+	if rpcErr.Message == "" {
+		rpcErr.Message = string(raw)
+	}
+	return &APIError{
+		StatusCode: statusCode,
+		Message:    rpcErr.Message,
+		Path:       path,
+		RPCCode:    rpcErr.Code,
+	}
+}
+
+// Is reports whether target is the sentinel error for e's status code.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.IsNotFound()
+	case ErrRateLimited:
+		return e.IsRateLimited()
+	case ErrUnauthorized:
+		return e.IsUnauthorized()
+	case ErrForbidden:
+		return e.IsForbidden()
+	case ErrServerError:
+		return e.IsServerError()
+	}
+	return false
+}
+
+// Unwrap returns the sentinel error for e's status code, or nil if there is
+// none.
+func (e *APIError) Unwrap() error {
+	switch {
+	case e.IsNotFound():
+		return ErrNotFound
+	case e.IsRateLimited():
+		return ErrRateLimited
+	case e.IsUnauthorized():
+		return ErrUnauthorized
+	case e.IsForbidden():
+		return ErrForbidden
+	case e.IsServerError():
+		return ErrServerError
+	}
+	return nil
 }
 
 // IsNotFound returns true if the error is a 404 Not Found.
@@ -53,6 +192,52 @@ func (e *APIError) IsForbidden() bool {
 	return e.StatusCode == http.StatusForbidden
 }
 
+// Default waits used by SuggestedRetryWait when the response had no
+// Retry-After header.
+const (
+	defaultRateLimitWait   = 1 * time.Second
+	defaultServerErrorWait = 2 * time.Second
+)
+
+// SuggestedRetryWait returns how long to wait before retrying the request:
+// the Retry-After duration if the response had one, otherwise 1s for a 429
+// and 2s for a 5xx. It returns zero for other errors, which are not worth
+// retrying as-is.
+//
+//	if apiErr, ok := helius.IsAPIError(err); ok && apiErr.IsRateLimited() {
+//	    time.Sleep(apiErr.SuggestedRetryWait())
+//	}
+func (e *APIError) SuggestedRetryWait() time.Duration {
+	switch {
+	case e.RetryAfter > 0:
+		return e.RetryAfter
+	case e.IsRateLimited():
+		return defaultRateLimitWait
+	case e.IsServerError():
+		return defaultServerErrorWait
+	}
+	return 0
+}
+
+// parseRetryAfter parses a Retry-After header value, given either as a
+// number of seconds or as an HTTP date relative to now. It returns zero if
+// the value is empty, malformed, or in the past.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(value); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
+
 // IsAPIError checks if an error is an APIError and returns it.
 // This works with wrapped errors using errors.As.
 func IsAPIError(err error) (*APIError, bool) {
@@ -62,3 +247,141 @@ func IsAPIError(err error) (*APIError, bool) {
 	}
 	return nil, false
 }
+
+// IsTransient reports whether err is a failure that may succeed if the
+// request is retried later: a 429 or 5xx *APIError, or a network error such
+// as a timeout, connection reset or refusal, broken pipe, or connection
+// closed mid-response.
+//
+// It returns false for nil, for context cancellation and deadline errors
+// (the caller gave up, so retrying would not help), for *ValidationError,
+// for other API errors, and for errors it does not recognize.
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if _, ok := IsValidationError(err); ok {
+		return false
+	}
+	if apiErr, ok := IsAPIError(err); ok {
+		return apiErr.IsRateLimited() || apiErr.IsServerError()
+	}
+	return isNetworkTransient(err)
+}
+
+// isNetworkTransient reports whether err is a network failure worth
+// retrying.
+func isNetworkTransient(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	var tempErr interface{ Temporary() bool }
+	if errors.As(err, &tempErr) && tempErr.Temporary() {
+		return true
+	}
+	for _, target := range []error{
+		syscall.ECONNRESET,
+		syscall.ECONNREFUSED,
+		syscall.ECONNABORTED,
+		syscall.EPIPE,
+		io.ErrUnexpectedEOF,
+		io.EOF,
+	} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidationError reports invalid input detected before any request is made.
+//
+// Unlike APIError, it never corresponds to an HTTP response.
+type ValidationError struct {
+	// Field is the name of the invalid argument or option.
+	Field string
+
+	// Message describes what is wrong with the field.
+	Message string
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("helius validation error: %s", e.Message)
+	}
+	return fmt.Sprintf("helius validation error: %s: %s", e.Field, e.Message)
+}
+
+// IsValidationError checks if an error is a ValidationError and returns it.
+// This works with wrapped errors using errors.As.
+func IsValidationError(err error) (*ValidationError, bool) {
+	var valErr *ValidationError
+	if errors.As(err, &valErr) {
+		return valErr, true
+	}
+	return nil, false
+}
+
+// MetadataError reports a failure to fetch or parse an asset's off-chain
+// JSON metadata. It is non-fatal: the asset itself was fetched successfully.
+type MetadataError struct {
+	// URI is the metadata URI that could not be loaded.
+	URI string
+
+	// Err is the underlying error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *MetadataError) Error() string {
+	return fmt.Sprintf("helius metadata error: %s: %v", e.URI, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *MetadataError) Unwrap() error {
+	return e.Err
+}
+
+// apiKeyParamPattern matches an api-key query parameter and its value.
+var apiKeyParamPattern = regexp.MustCompile(`(?i)(api[-_]key=)[^&\s"']*`)
+
+// redactAPIKeyParams replaces the value of every api-key parameter in s.
+func redactAPIKeyParams(s string) string {
+	return apiKeyParamPattern.ReplaceAllString(s, "${1}"+redactedValue)
+}
+
+// redactedError wraps a transport error, whose text usually includes the
+// request URL and so the API key, and redacts the api-key parameter from its
+// text. Only the parameter's value is replaced, so that a short key does not
+// also mangle unrelated text that happens to contain it. The wrapped error
+// remains available to errors.Is and errors.As.
+type redactedError struct {
+	err error
+}
+
+// newRedactedError returns err wrapped in a redactedError. A *url.Error is
+// replaced by a copy with the api-key parameter redacted from its URL, so
+// that the key does not leak through errors.As either.
+func newRedactedError(err error) *redactedError {
+	if urlErr, ok := err.(*url.Error); ok {
+		redacted := *urlErr
+		redacted.URL = redactAPIKeyParams(urlErr.URL)
+		err = &redacted
+	}
+	return &redactedError{err: err}
+}
+
+// Error implements the error interface.
+func (e *redactedError) Error() string {
+	return redactAPIKeyParams(e.err.Error())
+}
+
+// Unwrap returns the underlying transport error.
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
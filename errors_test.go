@@ -1,10 +1,19 @@
 package helius
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"syscall"
 	"testing"
+	"time"
 )
 
 func TestAPIError_Error(t *testing.T) {
@@ -40,6 +49,16 @@ func TestAPIError_Error(t *testing.T) {
 			},
 			expected: "helius api error: /priority-fee returned status 500: internal server error",
 		},
+		{
+			name: "rpc error",
+			err: &APIError{
+				StatusCode: 200,
+				Message:    "Invalid params",
+				Path:       "/priority-fee",
+				RPCCode:    -32602,
+			},
+			expected: "helius api error: /priority-fee returned rpc error -32602: Invalid params",
+		},
 	}
 
 	for _, tt := range tests {
@@ -51,6 +70,140 @@ func TestAPIError_Error(t *testing.T) {
 	}
 }
 
+func TestAPIError_SafeError(t *testing.T) {
+	const apiKey = "super-secret-key"
+	err := &APIError{
+		StatusCode: 502,
+		Message:    "upstream fetch of https://api.helius.xyz/v0/assets?api-key=" + apiKey + "&page=2 failed",
+		Path:       "/assets?api-key=" + apiKey,
+	}
+
+	for name, got := range map[string]string{"Error": err.Error(), "SafeError": err.SafeError()} {
+		if strings.Contains(got, apiKey) {
+			t.Errorf("%s() = %q, contains the API key", name, got)
+		}
+	}
+
+	want := "helius api error: /assets returned status 502: upstream fetch of https://api.helius.xyz/v0/assets?api-key=REDACTED&page=2 failed"
+	if got := err.SafeError(); got != want {
+		t.Errorf("SafeError() = %q, want %q", got, want)
+	}
+}
+
+func TestRPCErrorFromBody(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		wantCode    int
+		wantMessage string
+	}{
+		{
+			name:        "error object",
+			body:        `{"jsonrpc":"2.0","error":{"code":-32602,"message":"Invalid params"},"id":"1"}`,
+			wantCode:    -32602,
+			wantMessage: "Invalid params",
+		},
+		{
+			name:        "error object without message",
+			body:        `{"error":{"code":-32000}}`,
+			wantCode:    -32000,
+			wantMessage: `{"code":-32000}`,
+		},
+		{name: "result", body: `{"priorityFeeEstimate":1000}`},
+		{name: "null error", body: `{"error":null,"result":1}`},
+		{name: "string error", body: `{"error":"not an object"}`},
+		{name: "array body", body: `[{"error":{"code":1}}]`},
+		{name: "invalid json", body: `{`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rpcErrorFromBody("/priority-fee", 200, []byte(tt.body))
+			if tt.wantCode == 0 {
+				if err != nil {
+					t.Errorf("rpcErrorFromBody() = %v, want nil", err)
+				}
+				return
+			}
+			apiErr, ok := IsAPIError(err)
+			if !ok {
+				t.Fatalf("rpcErrorFromBody() = %v, want *APIError", err)
+			}
+			if apiErr.RPCCode != tt.wantCode || apiErr.Message != tt.wantMessage || apiErr.StatusCode != 200 {
+				t.Errorf("APIError = %+v, want RPCCode %d, Message %q, StatusCode 200", apiErr, tt.wantCode, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestTransportErrorRedactsAPIKey(t *testing.T) {
+	const apiKey = "super-secret-key"
+	client, _ := NewClient(apiKey, WithAPIURL("http://127.0.0.1:0"), WithoutRetries())
+
+	_, err := client.GetAsset(context.Background(), "asset-1")
+	if err == nil {
+		t.Fatal("expected transport error")
+	}
+	if strings.Contains(err.Error(), apiKey) {
+		t.Errorf("Error() = %q, contains the API key", err)
+	}
+	if !strings.Contains(err.Error(), "api-key=REDACTED") {
+		t.Errorf("Error() = %q, want redacted api-key parameter", err)
+	}
+
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		t.Fatalf("errors.As(*url.Error) failed for %v", err)
+	}
+	if strings.Contains(urlErr.Error(), apiKey) || strings.Contains(urlErr.URL, apiKey) {
+		t.Errorf("unwrapped *url.Error = %q, contains the API key", urlErr)
+	}
+
+	t.Run("short key", func(t *testing.T) {
+		client, _ := NewClient("k", WithAPIURL("http://127.0.0.1:0"), WithoutRetries())
+
+		_, err := client.GetAsset(context.Background(), "asset-1")
+		if err == nil {
+			t.Fatal("expected transport error")
+		}
+		if !strings.Contains(err.Error(), "?api-key=REDACTED") {
+			t.Errorf("Error() = %q, want only the api-key value redacted", err)
+		}
+		if strings.Contains(err.Error(), "REDACTEDey") {
+			t.Errorf("Error() = %q, redacted the key inside other text", err)
+		}
+	})
+}
+
+func TestAPIErrorLogRedactsBody(t *testing.T) {
+	const apiKey = "super-secret-key"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"bad webhook","authHeader":"Bearer token","url":"%s"}`, r.URL)
+	}))
+	defer server.Close()
+
+	logger := &recordingLogger{}
+	client, _ := NewClient(apiKey, WithAPIURL(server.URL), WithLogger(logger))
+	if _, err := client.GetAsset(context.Background(), "asset-1"); err == nil {
+		t.Fatal("expected APIError")
+	}
+
+	var logged bool
+	for _, entry := range logger.entries {
+		if !strings.HasPrefix(entry, "api error") {
+			continue
+		}
+		logged = true
+		if strings.Contains(entry, apiKey) || strings.Contains(entry, "Bearer token") {
+			t.Errorf("logged %q, want the API key and auth header redacted", entry)
+		}
+	}
+	if !logged {
+		t.Error("api error was not logged")
+	}
+}
+
 func TestAPIError_IsNotFound(t *testing.T) {
 	tests := []struct {
 		statusCode int
@@ -240,3 +393,254 @@ func TestAPIError_ImplementsError(t *testing.T) {
 	// Compile-time check that APIError implements error interface
 	var _ error = (*APIError)(nil)
 }
+
+func TestErrMissingAPIKey(t *testing.T) {
+	_, err := NewClient("")
+	if !errors.Is(err, ErrMissingAPIKey) {
+		t.Errorf("errors.Is(err, ErrMissingAPIKey) = false for %v", err)
+	}
+
+	wrapped := fmt.Errorf("init: %w", err)
+	if !errors.Is(wrapped, ErrMissingAPIKey) {
+		t.Error("errors.Is should match wrapped ErrMissingAPIKey")
+	}
+}
+
+func TestValidationError_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      *ValidationError
+		expected string
+	}{
+		{
+			name:     "with field",
+			err:      &ValidationError{Field: "id", Message: "asset ID is required"},
+			expected: "helius validation error: id: asset ID is required",
+		},
+		{
+			name:     "without field",
+			err:      &ValidationError{Message: "invalid input"},
+			expected: "helius validation error: invalid input",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.expected {
+				t.Errorf("Error() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsValidationError(t *testing.T) {
+	t.Run("direct ValidationError", func(t *testing.T) {
+		err := &ValidationError{Field: "mint", Message: "mint address is required"}
+		valErr, ok := IsValidationError(err)
+		if !ok {
+			t.Fatal("IsValidationError should return true for ValidationError")
+		}
+		if valErr.Field != "mint" {
+			t.Errorf("Field = %s, want mint", valErr.Field)
+		}
+	})
+
+	t.Run("wrapped ValidationError", func(t *testing.T) {
+		wrapped := fmt.Errorf("lookup: %w", &ValidationError{Field: "id"})
+		if _, ok := IsValidationError(wrapped); !ok {
+			t.Error("IsValidationError should return true for wrapped ValidationError")
+		}
+	})
+
+	t.Run("APIError is not ValidationError", func(t *testing.T) {
+		if _, ok := IsValidationError(&APIError{StatusCode: 400}); ok {
+			t.Error("IsValidationError should return false for APIError")
+		}
+	})
+
+	t.Run("nil error", func(t *testing.T) {
+		if _, ok := IsValidationError(nil); ok {
+			t.Error("IsValidationError should return false for nil error")
+		}
+	})
+}
+
+func TestAPIError_Is(t *testing.T) {
+	sentinels := []error{ErrNotFound, ErrRateLimited, ErrUnauthorized, ErrForbidden, ErrServerError}
+
+	tests := []struct {
+		statusCode int
+		want       error
+	}{
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusInternalServerError, ErrServerError},
+		{http.StatusBadGateway, ErrServerError},
+		{http.StatusBadRequest, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.statusCode), func(t *testing.T) {
+			err := fmt.Errorf("get asset: %w", &APIError{StatusCode: tt.statusCode, Path: "/assets"})
+
+			for _, sentinel := range sentinels {
+				if got := errors.Is(err, sentinel); got != (sentinel == tt.want) {
+					t.Errorf("errors.Is(err, %v) = %v, want %v", sentinel, got, sentinel == tt.want)
+				}
+			}
+			if got := (&APIError{StatusCode: tt.statusCode}).Unwrap(); got != tt.want {
+				t.Errorf("Unwrap() = %v, want %v", got, tt.want)
+			}
+			if _, ok := IsAPIError(err); !ok {
+				t.Error("IsAPIError should still find the wrapped APIError")
+			}
+		})
+	}
+}
+
+func TestAPIError_SuggestedRetryWait(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *APIError
+		want time.Duration
+	}{
+		{"retry-after on 429", &APIError{StatusCode: 429, RetryAfter: 7 * time.Second}, 7 * time.Second},
+		{"retry-after on 503", &APIError{StatusCode: 503, RetryAfter: 30 * time.Second}, 30 * time.Second},
+		{"429 without retry-after", &APIError{StatusCode: 429}, time.Second},
+		{"503 without retry-after", &APIError{StatusCode: 503}, 2 * time.Second},
+		{"500 without retry-after", &APIError{StatusCode: 500}, 2 * time.Second},
+		{"not retryable", &APIError{StatusCode: 400}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.SuggestedRetryWait(); got != tt.want {
+				t.Errorf("SuggestedRetryWait() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("parsed from response", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "5")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL), WithoutRetries())
+		_, err := client.GetAsset(context.Background(), "asset-1")
+		apiErr, ok := IsAPIError(err)
+		if !ok {
+			t.Fatalf("err = %v, want APIError", err)
+		}
+		if got := apiErr.SuggestedRetryWait(); got != 5*time.Second {
+			t.Errorf("SuggestedRetryWait() = %v, want 5s", got)
+		}
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"", 0},
+		{"3", 3 * time.Second},
+		{"-1", 0},
+		{"soon", 0},
+		{now.Add(90 * time.Second).Format(http.TimeFormat), 90 * time.Second},
+		{now.Add(-time.Minute).Format(http.TimeFormat), 0},
+	}
+
+	for _, tt := range tests {
+		if got := parseRetryAfter(tt.value, now); got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+// fakeNetError is a synthetic net.Error.
+type fakeNetError struct {
+	timeout   bool
+	temporary bool
+}
+
+func (e fakeNetError) Error() string   { return "fake network error" }
+func (e fakeNetError) Timeout() bool   { return e.timeout }
+func (e fakeNetError) Temporary() bool { return e.temporary }
+
+func TestIsTransient(t *testing.T) {
+	reset := &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"timeout", fakeNetError{timeout: true}, true},
+		{"temporary", fakeNetError{temporary: true}, true},
+		{"permanent net error", fakeNetError{}, false},
+		{"connection reset", reset, true},
+		{"wrapped connection reset", fmt.Errorf("do request: %w", &url.Error{Op: "Post", URL: "https://api.helius.xyz", Err: reset}), true},
+		{"connection refused", fmt.Errorf("dial: %w", syscall.ECONNREFUSED), true},
+		{"unexpected eof", fmt.Errorf("read response: %w", io.ErrUnexpectedEOF), true},
+		{"context canceled", fmt.Errorf("do request: %w", context.Canceled), false},
+		{"deadline exceeded", context.DeadlineExceeded, false},
+		{"rate limited", &APIError{StatusCode: http.StatusTooManyRequests}, true},
+		{"server error", fmt.Errorf("get asset: %w", &APIError{StatusCode: http.StatusBadGateway}), true},
+		{"not found", &APIError{StatusCode: http.StatusNotFound}, false},
+		{"validation", &ValidationError{Field: "id", Message: "required"}, false},
+		{"unknown", errors.New("something else"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsTransient(tt.err); got != tt.want {
+				t.Errorf("IsTransient(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsTransient_RetriesExhausted(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		// Only the last response carries Retry-After, which the retrying
+		// client would otherwise honor between attempts.
+		if calls == 2 {
+			w.Header().Set("Retry-After", "7")
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	// A real retrying client, with waits short enough for a test.
+	client, _ := NewClient("test-key", WithAPIURL(server.URL), WithMaxRetries(1), func(cfg *config) {
+		cfg.retryWaitMin = time.Millisecond
+		cfg.retryWaitMax = time.Millisecond
+	})
+
+	_, err := client.GetAsset(context.Background(), "asset-1")
+	if calls != 2 {
+		t.Errorf("server called %d times, want 2", calls)
+	}
+	apiErr, ok := IsAPIError(err)
+	if !ok {
+		t.Fatalf("err = %v, want *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("StatusCode = %d, want 503", apiErr.StatusCode)
+	}
+	if !IsTransient(err) {
+		t.Errorf("IsTransient(%v) = false, want true", err)
+	}
+	if wait := apiErr.SuggestedRetryWait(); wait != 7*time.Second {
+		t.Errorf("SuggestedRetryWait() = %v, want 7s", wait)
+	}
+}
@@ -0,0 +1,54 @@
+package helius
+
+import (
+	"context"
+	"log/slog"
+)
+
+// missingValue is appended to an odd-length keysAndValues list.
+const missingValue = "(MISSING)"
+
+// slogLogger adapts a *slog.Logger to the Logger interface.
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+// NewSlogLogger returns a Logger that writes to l using the matching slog
+// levels. keysAndValues are passed through as slog attributes; a trailing
+// key without a value is paired with "(MISSING)". If l is nil, slog.Default()
+// is used.
+//
+// Example:
+//
+//	client, err := helius.NewClient(apiKey,
+//	    helius.WithLogger(helius.NewSlogLogger(slog.Default())),
+//	)
+func NewSlogLogger(l *slog.Logger) Logger {
+	if l == nil {
+		l = slog.Default()
+	}
+	return slogLogger{logger: l}
+}
+
+func (s slogLogger) Debug(msg string, keysAndValues ...interface{}) {
+	s.log(slog.LevelDebug, msg, keysAndValues)
+}
+
+func (s slogLogger) Info(msg string, keysAndValues ...interface{}) {
+	s.log(slog.LevelInfo, msg, keysAndValues)
+}
+
+func (s slogLogger) Warn(msg string, keysAndValues ...interface{}) {
+	s.log(slog.LevelWarn, msg, keysAndValues)
+}
+
+func (s slogLogger) Error(msg string, keysAndValues ...interface{}) {
+	s.log(slog.LevelError, msg, keysAndValues)
+}
+
+func (s slogLogger) log(level slog.Level, msg string, keysAndValues []interface{}) {
+	if len(keysAndValues)%2 != 0 {
+		keysAndValues = append(keysAndValues[:len(keysAndValues):len(keysAndValues)], missingValue)
+	}
+	s.logger.Log(context.Background(), level, msg, keysAndValues...)
+}
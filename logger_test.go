@@ -0,0 +1,97 @@
+package helius
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+)
+
+// captureHandler records slog records for inspection.
+type captureHandler struct {
+	records []slog.Record
+}
+
+func (h *captureHandler) Enabled(context.Context, slog.Level) bool { return true }
+
+func (h *captureHandler) Handle(_ context.Context, r slog.Record) error {
+	h.records = append(h.records, r)
+	return nil
+}
+
+func (h *captureHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+func (h *captureHandler) WithGroup(string) slog.Handler      { return h }
+
+func recordAttrs(r slog.Record) map[string]string {
+	attrs := map[string]string{}
+	r.Attrs(func(a slog.Attr) bool {
+		attrs[a.Key] = a.Value.String()
+		return true
+	})
+	return attrs
+}
+
+func TestNewSlogLogger(t *testing.T) {
+	t.Run("levels", func(t *testing.T) {
+		h := &captureHandler{}
+		logger := NewSlogLogger(slog.New(h))
+
+		logger.Debug("debug msg")
+		logger.Info("info msg")
+		logger.Warn("warn msg")
+		logger.Error("error msg")
+
+		want := []struct {
+			level slog.Level
+			msg   string
+		}{
+			{slog.LevelDebug, "debug msg"},
+			{slog.LevelInfo, "info msg"},
+			{slog.LevelWarn, "warn msg"},
+			{slog.LevelError, "error msg"},
+		}
+		if len(h.records) != len(want) {
+			t.Fatalf("len(records) = %d, want %d", len(h.records), len(want))
+		}
+		for i, w := range want {
+			if h.records[i].Level != w.level {
+				t.Errorf("records[%d].Level = %v, want %v", i, h.records[i].Level, w.level)
+			}
+			if h.records[i].Message != w.msg {
+				t.Errorf("records[%d].Message = %q, want %q", i, h.records[i].Message, w.msg)
+			}
+		}
+	})
+
+	t.Run("key value attrs", func(t *testing.T) {
+		h := &captureHandler{}
+		logger := NewSlogLogger(slog.New(h))
+
+		logger.Info("fetched asset", "id", "asset-1", "count", 3)
+
+		attrs := recordAttrs(h.records[0])
+		if attrs["id"] != "asset-1" {
+			t.Errorf("id = %q, want asset-1", attrs["id"])
+		}
+		if attrs["count"] != "3" {
+			t.Errorf("count = %q, want 3", attrs["count"])
+		}
+	})
+
+	t.Run("odd key values", func(t *testing.T) {
+		h := &captureHandler{}
+		logger := NewSlogLogger(slog.New(h))
+
+		logger.Warn("odd", "id", "asset-1", "dangling")
+
+		attrs := recordAttrs(h.records[0])
+		if attrs["dangling"] != missingValue {
+			t.Errorf("dangling = %q, want %q", attrs["dangling"], missingValue)
+		}
+	})
+
+	t.Run("nil logger uses default", func(t *testing.T) {
+		if NewSlogLogger(nil) == nil {
+			t.Error("NewSlogLogger(nil) should return a logger")
+		}
+	})
+}
@@ -0,0 +1,138 @@
+package helius
+
+import (
+	"context"
+	"fmt"
+)
+
+// maxSellerFeeBasisPoints is 100% royalties.
+const maxSellerFeeBasisPoints = 10000
+
+// MintCreator is a creator of a minted NFT and their royalty share.
+type MintCreator struct {
+	// Address is the creator's wallet address.
+	Address string `json:"address"`
+
+	// Share is the creator's percentage of royalties. Shares across all
+	// creators must add up to 100.
+	Share int `json:"share"`
+}
+
+// MintCompressedNFTRequest describes a compressed NFT to mint with the Mint
+// API.
+type MintCompressedNFTRequest struct {
+	// Name is the NFT's name (required).
+	Name string `json:"name"`
+
+	// Symbol is the NFT's symbol.
+	Symbol string `json:"symbol,omitempty"`
+
+	// Owner is the address that will own the NFT (required).
+	Owner string `json:"owner"`
+
+	// Delegate is an optional address given delegate authority over the NFT.
+	Delegate string `json:"delegate,omitempty"`
+
+	// Collection is the address of the collection to mint into.
+	Collection string `json:"collection,omitempty"`
+
+	// URI is the URI of the NFT's off-chain JSON metadata (required).
+	URI string `json:"uri"`
+
+	// SellerFeeBasisPoints is the royalty, from 0 to 10000 (100%).
+	SellerFeeBasisPoints int `json:"sellerFeeBasisPoints,omitempty"`
+
+	// Creators lists the NFT's creators and their royalty shares.
+	Creators []MintCreator `json:"creators,omitempty"`
+
+	// ImageURL is the URL of the NFT's image.
+	ImageURL string `json:"imageUrl,omitempty"`
+}
+
+// MintResponse is the result of minting a compressed NFT.
+type MintResponse struct {
+	// Signature is the mint transaction signature.
+	Signature string `json:"signature"`
+
+	// AssetID is the ID of the new asset, usable with GetAsset.
+	AssetID string `json:"assetId"`
+
+	// Minted reports whether the mint transaction was confirmed.
+	Minted bool `json:"minted"`
+}
+
+// MintCompressedNFT mints a compressed NFT through the Helius Mint API. The
+// API pays for the mint, so the key's plan must include Mint API access.
+//
+// Example:
+//
+//	resp, err := client.MintCompressedNFT(ctx, &helius.MintCompressedNFTRequest{
+//	    Name:  "Ticket #1",
+//	    Owner: "owner-wallet",
+//	    URI:   "https://example.com/ticket-1.json",
+//	})
+func (c *Client) MintCompressedNFT(ctx context.Context, req *MintCompressedNFTRequest) (*MintResponse, error) {
+	if req == nil {
+		return nil, &ValidationError{
+			Field:   "req",
+			Message: "request is required",
+		}
+	}
+	if req.Name == "" {
+		return nil, &ValidationError{
+			Field:   "name",
+			Message: "name is required",
+		}
+	}
+	if req.Owner == "" {
+		return nil, &ValidationError{
+			Field:   "owner",
+			Message: "owner is required",
+		}
+	}
+	if err := c.checkAddress("owner", req.Owner); err != nil {
+		return nil, err
+	}
+	if req.URI == "" {
+		return nil, &ValidationError{
+			Field:   "uri",
+			Message: "uri is required",
+		}
+	}
+	if req.SellerFeeBasisPoints < 0 || req.SellerFeeBasisPoints > maxSellerFeeBasisPoints {
+		return nil, &ValidationError{
+			Field:   "sellerFeeBasisPoints",
+			Message: fmt.Sprintf("must be between 0 and %d", maxSellerFeeBasisPoints),
+		}
+	}
+	if len(req.Creators) > 0 {
+		total := 0
+		for _, creator := range req.Creators {
+			total += creator.Share
+		}
+		if total != 100 {
+			return nil, &ValidationError{
+				Field:   "creators",
+				Message: fmt.Sprintf("shares add up to %d, want 100", total),
+			}
+		}
+	}
+
+	body, err := c.doRPCPost(ctx, "/mint", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp MintResponse
+	if err := c.decode(body, &resp); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+
+	c.logger.Info("minted compressed nft",
+		"assetID", resp.AssetID,
+		"signature", resp.Signature,
+		"minted", resp.Minted,
+	)
+
+	return &resp, nil
+}
@@ -0,0 +1,91 @@
+package helius
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMintCompressedNFT(t *testing.T) {
+	t.Run("successful mint", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/mint" {
+				t.Errorf("expected /mint, got %s", r.URL.Path)
+			}
+
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+			if req["name"] != "Ticket #1" || req["owner"] != "owner-wallet" || req["uri"] != "https://example.com/1.json" {
+				t.Errorf("request = %v, missing required fields", req)
+			}
+			if req["sellerFeeBasisPoints"] != float64(500) {
+				t.Errorf("sellerFeeBasisPoints = %v, want 500", req["sellerFeeBasisPoints"])
+			}
+			creators, _ := req["creators"].([]interface{})
+			if len(creators) != 2 {
+				t.Errorf("creators = %v, want 2", req["creators"])
+			}
+			if _, ok := req["delegate"]; ok {
+				t.Error("empty delegate should be omitted")
+			}
+
+			w.Write([]byte(`{"signature":"5wHu1qwD7q5","minted":true,"assetId":"JDuAmJgJ7fmUJhtsiTUvdqYzznuEygrkwQZGbsoTCs6w"}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		resp, err := client.MintCompressedNFT(context.Background(), &MintCompressedNFTRequest{
+			Name:                 "Ticket #1",
+			Symbol:               "TIX",
+			Owner:                "owner-wallet",
+			URI:                  "https://example.com/1.json",
+			SellerFeeBasisPoints: 500,
+			Creators: []MintCreator{
+				{Address: "creator-1", Share: 60},
+				{Address: "creator-2", Share: 40},
+			},
+		})
+		if err != nil {
+			t.Fatalf("MintCompressedNFT returned error: %v", err)
+		}
+		if resp.Signature != "5wHu1qwD7q5" || resp.AssetID != "JDuAmJgJ7fmUJhtsiTUvdqYzznuEygrkwQZGbsoTCs6w" || !resp.Minted {
+			t.Errorf("resp = %+v, unexpected", resp)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		client, _ := NewClient("test-key")
+		valid := MintCompressedNFTRequest{Name: "n", Owner: "o", URI: "u"}
+
+		tests := []struct {
+			name   string
+			modify func(*MintCompressedNFTRequest)
+			field  string
+		}{
+			{"missing name", func(r *MintCompressedNFTRequest) { r.Name = "" }, "name"},
+			{"missing owner", func(r *MintCompressedNFTRequest) { r.Owner = "" }, "owner"},
+			{"missing uri", func(r *MintCompressedNFTRequest) { r.URI = "" }, "uri"},
+			{"royalty too high", func(r *MintCompressedNFTRequest) { r.SellerFeeBasisPoints = 10001 }, "sellerFeeBasisPoints"},
+			{"shares not 100", func(r *MintCompressedNFTRequest) {
+				r.Creators = []MintCreator{{Address: "a", Share: 50}, {Address: "b", Share: 40}}
+			}, "creators"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				req := valid
+				tt.modify(&req)
+				_, err := client.MintCompressedNFT(context.Background(), &req)
+				if valErr, ok := IsValidationError(err); !ok || valErr.Field != tt.field {
+					t.Errorf("err = %v, want ValidationError for %s", err, tt.field)
+				}
+			})
+		}
+
+		if _, err := client.MintCompressedNFT(context.Background(), nil); err == nil {
+			t.Error("expected error for nil request")
+		}
+	})
+}
@@ -0,0 +1,146 @@
+package helius
+
+import "context"
+
+// PageFetcher fetches the page of items at cursor, where the first page has
+// an empty cursor, and returns the cursor of the next page, or "" if it is
+// the last one.
+type PageFetcher[T any] func(ctx context.Context, cursor string) (items []T, nextCursor string, err error)
+
+// Paginator iterates over the items of any cursor-paginated endpoint one at
+// a time, fetching a page whenever the previous one is used up. Pagination
+// ends after a page with no next cursor, or an empty page.
+//
+// A Paginator is not safe for concurrent use.
+//
+// Example:
+//
+//	p := client.AssetsByOwnerPaginator(owner, &helius.AssetsByOwnerOptions{Limit: 1000})
+//	for {
+//	    asset, err := p.Next(ctx)
+//	    if errors.Is(err, helius.ErrIteratorDone) {
+//	        break
+//	    }
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	    fmt.Println(asset.ID)
+//	}
+type Paginator[T any] struct {
+	fetch  PageFetcher[T]
+	cursor string
+	items  []T
+	done   bool
+}
+
+// NewPaginator returns a Paginator that fetches pages with fetch, starting
+// at cursor; pass "" to start at the first page.
+func NewPaginator[T any](cursor string, fetch PageFetcher[T]) *Paginator[T] {
+	return &Paginator[T]{fetch: fetch, cursor: cursor}
+}
+
+// Next returns the next item, or ErrIteratorDone once all items have been
+// returned. If fetching a page fails, Next returns the error; calling Next
+// again retries the same page.
+func (p *Paginator[T]) Next(ctx context.Context) (T, error) {
+	var zero T
+	for len(p.items) == 0 {
+		if p.done {
+			return zero, ErrIteratorDone
+		}
+
+		items, next, err := p.fetch(ctx, p.cursor)
+		if err != nil {
+			return zero, err
+		}
+		p.items = items
+		p.cursor = next
+		if next == "" || len(items) == 0 {
+			p.done = true
+		}
+	}
+
+	item := p.items[0]
+	p.items = p.items[1:]
+	return item, nil
+}
+
+// Cursor returns the cursor of the next page to be fetched, or "" if there
+// is none. Pass it to NewPaginator to resume later; items of the current page
+// not yet returned by Next are not covered by it.
+func (p *Paginator[T]) Cursor() string {
+	if p.done {
+		return ""
+	}
+	return p.cursor
+}
+
+// limitPages wraps fetch so that, once maxPages pages have been fetched, a
+// request for another fails with ErrMaxPagesExceeded. Zero means no limit.
+func limitPages[T any](maxPages int, fetch PageFetcher[T]) PageFetcher[T] {
+	var pages int
+	return func(ctx context.Context, cursor string) ([]T, string, error) {
+		if maxPages > 0 && pages >= maxPages {
+			return nil, "", ErrMaxPagesExceeded
+		}
+		items, next, err := fetch(ctx, cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		pages++
+		return items, next, nil
+	}
+}
+
+// AssetsByOwnerPaginator returns a Paginator over the assets owned by
+// ownerAddress, fetched with GetAssetsByOwner and opts. Pages are followed by
+// cursor, starting at opts.Cursor, so opts.Page is ignored. A page that fails
+// with a transient error is retried as in GetAllTokenHolders, and Next fails
+// with ErrMaxPagesExceeded after the WithMaxPages limit.
+func (c *Client) AssetsByOwnerPaginator(ownerAddress string, opts *AssetsByOwnerOptions) *Paginator[Asset] {
+	var pageOpts AssetsByOwnerOptions
+	if opts != nil {
+		pageOpts = *opts
+	}
+	pageOpts.Page = 0
+
+	return NewPaginator(pageOpts.Cursor, limitPages(c.maxPages, func(ctx context.Context, cursor string) ([]Asset, string, error) {
+		reqOpts := pageOpts
+		reqOpts.Cursor = cursor
+		page, err := retryPage(ctx, c, func() (*AssetsPage, error) {
+			return c.GetAssetsByOwner(ctx, ownerAddress, &reqOpts)
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Items, page.Cursor, nil
+	}))
+}
+
+// TokenHoldersPaginator returns a Paginator over the holders of mint,
+// fetched with GetTokenHolders at the maximum page size. A page that fails
+// with a transient error is retried as in GetAllTokenHolders, and Next fails
+// with ErrMaxPagesExceeded after the WithMaxPages limit.
+func (c *Client) TokenHoldersPaginator(mint string) *Paginator[TokenHolder] {
+	return c.tokenHoldersPaginator(mint, nil)
+}
+
+// tokenHoldersPaginator implements TokenHoldersPaginator, calling onPage, if
+// non-nil, with each page as it is fetched.
+func (c *Client) tokenHoldersPaginator(mint string, onPage func(page *TokenHoldersPage)) *Paginator[TokenHolder] {
+	return NewPaginator("", limitPages(c.maxPages, func(ctx context.Context, cursor string) ([]TokenHolder, string, error) {
+		page, err := retryPage(ctx, c, func() (*TokenHoldersPage, error) {
+			return c.GetTokenHolders(ctx, mint, &GetTokenHoldersOptions{
+				Cursor: cursor,
+				Limit:  10000, // Max per page
+			})
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		if onPage != nil {
+			onPage(page)
+		}
+		return page.TokenHolders, page.Cursor, nil
+	}))
+}
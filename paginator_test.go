@@ -0,0 +1,257 @@
+package helius
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// syntheticPages returns a PageFetcher serving pages of ints, keyed by
+// cursor, and a pointer to the cursors it was called with.
+func syntheticPages(pages map[string][]int, next map[string]string) (PageFetcher[int], *[]string) {
+	var calls []string
+	return func(ctx context.Context, cursor string) ([]int, string, error) {
+		calls = append(calls, cursor)
+		items, ok := pages[cursor]
+		if !ok {
+			return nil, "", fmt.Errorf("unexpected cursor %q", cursor)
+		}
+		return items, next[cursor], nil
+	}, &calls
+}
+
+// drain calls Next until it fails, returning the items and the final error.
+func drain[T any](p *Paginator[T]) ([]T, error) {
+	var items []T
+	for {
+		item, err := p.Next(context.Background())
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+	}
+}
+
+func TestPaginator(t *testing.T) {
+	tests := []struct {
+		name      string
+		start     string
+		pages     map[string][]int
+		next      map[string]string
+		wantItems []int
+		wantCalls []string
+	}{
+		{
+			name:      "multiple pages",
+			pages:     map[string][]int{"": {1, 2}, "c2": {3, 4}, "c3": {5}},
+			next:      map[string]string{"": "c2", "c2": "c3"},
+			wantItems: []int{1, 2, 3, 4, 5},
+			wantCalls: []string{"", "c2", "c3"},
+		},
+		{
+			name:      "single page",
+			pages:     map[string][]int{"": {1, 2, 3}},
+			wantItems: []int{1, 2, 3},
+			wantCalls: []string{""},
+		},
+		{
+			name:      "no items",
+			pages:     map[string][]int{"": {}},
+			wantCalls: []string{""},
+		},
+		{
+			name:      "empty page with cursor ends",
+			pages:     map[string][]int{"": {1}, "c2": {}},
+			next:      map[string]string{"": "c2", "c2": "c3"},
+			wantItems: []int{1},
+			wantCalls: []string{"", "c2"},
+		},
+		{
+			name:      "starting cursor",
+			start:     "c2",
+			pages:     map[string][]int{"c2": {3, 4}, "c3": {5}},
+			next:      map[string]string{"c2": "c3"},
+			wantItems: []int{3, 4, 5},
+			wantCalls: []string{"c2", "c3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetch, calls := syntheticPages(tt.pages, tt.next)
+			p := NewPaginator(tt.start, fetch)
+
+			items, err := drain(p)
+			if !errors.Is(err, ErrIteratorDone) {
+				t.Fatalf("Next returned %v, want ErrIteratorDone", err)
+			}
+			if !reflect.DeepEqual(items, tt.wantItems) {
+				t.Errorf("items = %v, want %v", items, tt.wantItems)
+			}
+			if !reflect.DeepEqual(*calls, tt.wantCalls) {
+				t.Errorf("cursors fetched = %q, want %q", *calls, tt.wantCalls)
+			}
+
+			// Done stays done without fetching again.
+			if _, err := p.Next(context.Background()); !errors.Is(err, ErrIteratorDone) {
+				t.Errorf("Next after done = %v, want ErrIteratorDone", err)
+			}
+			if len(*calls) != len(tt.wantCalls) {
+				t.Errorf("fetched %d times after done, want %d", len(*calls), len(tt.wantCalls))
+			}
+			if p.Cursor() != "" {
+				t.Errorf("Cursor() = %q after done, want empty", p.Cursor())
+			}
+		})
+	}
+
+	t.Run("error then retry", func(t *testing.T) {
+		errBoom := errors.New("boom")
+		failures := 1
+		var calls []string
+		p := NewPaginator("", func(ctx context.Context, cursor string) ([]int, string, error) {
+			calls = append(calls, cursor)
+			if cursor == "c2" && failures > 0 {
+				failures--
+				return nil, "", errBoom
+			}
+			if cursor == "" {
+				return []int{1}, "c2", nil
+			}
+			return []int{2}, "", nil
+		})
+
+		if item, err := p.Next(context.Background()); err != nil || item != 1 {
+			t.Fatalf("Next = %d, %v, want 1, nil", item, err)
+		}
+		if _, err := p.Next(context.Background()); !errors.Is(err, errBoom) {
+			t.Fatalf("Next = %v, want errBoom", err)
+		}
+		if p.Cursor() != "c2" {
+			t.Errorf("Cursor() = %q, want c2", p.Cursor())
+		}
+		if item, err := p.Next(context.Background()); err != nil || item != 2 {
+			t.Fatalf("Next after retry = %d, %v, want 2, nil", item, err)
+		}
+		if want := []string{"", "c2", "c2"}; !reflect.DeepEqual(calls, want) {
+			t.Errorf("cursors fetched = %q, want %q", calls, want)
+		}
+	})
+}
+
+func TestAssetsByOwnerPaginator(t *testing.T) {
+	pages := map[string]AssetsPage{
+		"":         {Cursor: "cursor-2", Items: []Asset{{ID: "asset-1"}, {ID: "asset-2"}}},
+		"cursor-2": {Items: []Asset{{ID: "asset-3"}}},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&req)
+		if _, ok := req["page"]; ok {
+			t.Errorf("page = %v, want omitted", req["page"])
+		}
+		cursor, _ := req["cursor"].(string)
+		json.NewEncoder(w).Encode(pages[cursor])
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-key", WithAPIURL(server.URL))
+	p := client.AssetsByOwnerPaginator("86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY", &AssetsByOwnerOptions{Page: 1, Limit: 2})
+
+	assets, err := drain(p)
+	if !errors.Is(err, ErrIteratorDone) {
+		t.Fatalf("Next returned %v, want ErrIteratorDone", err)
+	}
+	var ids []string
+	for _, a := range assets {
+		ids = append(ids, a.ID)
+	}
+	if want := []string{"asset-1", "asset-2", "asset-3"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("ids = %v, want %v", ids, want)
+	}
+}
+
+func TestTokenHoldersPaginator(t *testing.T) {
+	pages := map[string]TokenHoldersPage{
+		"":       {Total: 3, Cursor: "page-2", TokenHolders: []TokenHolder{{Owner: "holder-1"}, {Owner: "holder-2"}}},
+		"page-2": {Total: 3, TokenHolders: []TokenHolder{{Owner: "holder-3"}}},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&req)
+		cursor, _ := req["cursor"].(string)
+		json.NewEncoder(w).Encode(pages[cursor])
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-key", WithAPIURL(server.URL))
+	holders, err := drain(client.TokenHoldersPaginator("some-mint"))
+	if !errors.Is(err, ErrIteratorDone) {
+		t.Fatalf("Next returned %v, want ErrIteratorDone", err)
+	}
+	if len(holders) != 3 || holders[2].Owner != "holder-3" {
+		t.Errorf("holders = %+v, want holder-1 to holder-3", holders)
+	}
+
+	t.Run("validation error", func(t *testing.T) {
+		_, err := client.TokenHoldersPaginator("").Next(context.Background())
+		if _, ok := IsValidationError(err); !ok {
+			t.Errorf("Next = %v, want ValidationError", err)
+		}
+	})
+}
+
+func TestPaginatorMaxPages(t *testing.T) {
+	// The server always returns another cursor, so only the page limit ends
+	// pagination.
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		next := fmt.Sprintf("cursor-%d", requests)
+		if r.URL.Path == "/token-holders" {
+			json.NewEncoder(w).Encode(TokenHoldersPage{Cursor: next, TokenHolders: []TokenHolder{{Owner: next}}})
+			return
+		}
+		json.NewEncoder(w).Encode(AssetsPage{Cursor: next, Items: []Asset{{ID: next}}})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-key", WithAPIURL(server.URL), WithMaxPages(3))
+
+	tests := []struct {
+		name string
+		next func() error
+	}{
+		{
+			name: "assets by owner",
+			next: func() error {
+				_, err := drain(client.AssetsByOwnerPaginator("86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY", nil))
+				return err
+			},
+		},
+		{
+			name: "token holders",
+			next: func() error {
+				_, err := drain(client.TokenHoldersPaginator("some-mint"))
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests = 0
+			if err := tt.next(); !errors.Is(err, ErrMaxPagesExceeded) {
+				t.Errorf("Next returned %v, want ErrMaxPagesExceeded", err)
+			}
+			if requests != 3 {
+				t.Errorf("requests = %d, want 3", requests)
+			}
+		})
+	}
+}
@@ -1,10 +1,11 @@
 package helius
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"sync"
 )
 
 // PriorityLevel represents the priority level for fee estimation.
@@ -34,6 +35,29 @@ type PriorityFeeEstimate struct {
 	PriorityFeeLevels *PriorityFeeLevels `json:"priorityFeeLevels,omitempty"`
 }
 
+// IsZero reports whether the estimate carries no fee at all: both
+// PriorityFeeEstimate and every level in PriorityFeeLevels are zero.
+func (e *PriorityFeeEstimate) IsZero() bool {
+	if e.PriorityFeeEstimate != 0 {
+		return false
+	}
+	l := e.PriorityFeeLevels
+	return l == nil || *l == PriorityFeeLevels{}
+}
+
+// applyMinFee raises the fees in e to at least min.
+func (e *PriorityFeeEstimate) applyMinFee(min float64) {
+	if min <= 0 {
+		return
+	}
+	e.PriorityFeeEstimate = math.Max(e.PriorityFeeEstimate, min)
+	if l := e.PriorityFeeLevels; l != nil {
+		for _, fee := range []*float64{&l.Min, &l.Low, &l.Medium, &l.High, &l.VeryHigh, &l.UnsafeMax} {
+			*fee = math.Max(*fee, min)
+		}
+	}
+}
+
 // PriorityFeeLevels contains fees for each priority level.
 type PriorityFeeLevels struct {
 	Min       float64 `json:"min"`
@@ -86,10 +110,14 @@ type GetPriorityFeeOptions struct {
 //	})
 func (c *Client) GetPriorityFeeEstimate(ctx context.Context, accountKeys []string, opts *GetPriorityFeeOptions) (*PriorityFeeEstimate, error) {
 	if len(accountKeys) == 0 {
-		return nil, &APIError{
-			StatusCode: 400,
-			Message:    "at least one account key is required",
-			Path:       "/priority-fee",
+		return nil, &ValidationError{
+			Field:   "accountKeys",
+			Message: "at least one account key is required",
+		}
+	}
+	for i, key := range accountKeys {
+		if err := c.checkAddress(fmt.Sprintf("accountKeys[%d]", i), key); err != nil {
+			return nil, err
 		}
 	}
 
@@ -123,20 +151,16 @@ func (c *Client) GetPriorityFeeEstimate(ctx context.Context, accountKeys []strin
 		}
 	}
 
-	jsonBody, err := json.Marshal(reqBody)
-	if err != nil {
-		return nil, fmt.Errorf("marshal request: %w", err)
-	}
-
-	body, err := c.doRequest(ctx, "POST", "/priority-fee", bytes.NewReader(jsonBody))
+	body, err := c.doRPCPost(ctx, "/priority-fee", reqBody)
 	if err != nil {
 		return nil, err
 	}
 
 	var estimate PriorityFeeEstimate
-	if err := json.Unmarshal(body, &estimate); err != nil {
+	if err := c.decode(body, &estimate); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
+	estimate.applyMinFee(c.minFee)
 
 	c.logger.Debug("got priority fee estimate",
 		"fee", estimate.PriorityFeeEstimate,
@@ -149,10 +173,18 @@ func (c *Client) GetPriorityFeeEstimate(ctx context.Context, accountKeys []strin
 // GetPriorityFeeEstimateForTransaction gets the estimated priority fee for a serialized transaction.
 func (c *Client) GetPriorityFeeEstimateForTransaction(ctx context.Context, transaction string, opts *GetPriorityFeeOptions) (*PriorityFeeEstimate, error) {
 	if transaction == "" {
-		return nil, &APIError{
-			StatusCode: 400,
-			Message:    "transaction is required",
-			Path:       "/priority-fee",
+		return nil, &ValidationError{
+			Field:   "transaction",
+			Message: "transaction is required",
+		}
+	}
+	if c.strict {
+		var enc string
+		if opts != nil {
+			enc = opts.TransactionEncoding
+		}
+		if err := IsValidTransactionEncoding(transaction, enc); err != nil {
+			return nil, err
 		}
 	}
 
@@ -187,20 +219,16 @@ func (c *Client) GetPriorityFeeEstimateForTransaction(ctx context.Context, trans
 		}
 	}
 
-	jsonBody, err := json.Marshal(reqBody)
-	if err != nil {
-		return nil, fmt.Errorf("marshal request: %w", err)
-	}
-
-	body, err := c.doRequest(ctx, "POST", "/priority-fee", bytes.NewReader(jsonBody))
+	body, err := c.doRPCPost(ctx, "/priority-fee", reqBody)
 	if err != nil {
 		return nil, err
 	}
 
 	var estimate PriorityFeeEstimate
-	if err := json.Unmarshal(body, &estimate); err != nil {
+	if err := c.decode(body, &estimate); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
+	estimate.applyMinFee(c.minFee)
 
 	c.logger.Debug("got priority fee estimate for transaction",
 		"fee", estimate.PriorityFeeEstimate,
@@ -209,6 +237,69 @@ func (c *Client) GetPriorityFeeEstimateForTransaction(ctx context.Context, trans
 	return &estimate, nil
 }
 
+// GetPriorityFeeEstimates gets priority fee estimates for many serialized
+// transactions at once.
+//
+// Requests are issued concurrently, up to the limit set with
+// WithFanoutConcurrency, and results are returned in the same order as
+// transactions. The first error cancels any
+// pending requests and is returned.
+func (c *Client) GetPriorityFeeEstimates(ctx context.Context, transactions []string, opts *GetPriorityFeeOptions) ([]PriorityFeeEstimate, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	workers := c.fanout
+	if workers < 1 {
+		workers = 1
+	}
+
+	estimates := make([]PriorityFeeEstimate, len(transactions))
+	sem := make(chan struct{}, workers)
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	setErr := func(err error) {
+		errOnce.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	for i, tx := range transactions {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if err := ctx.Err(); err != nil {
+			setErr(err)
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, tx string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			estimate, err := c.GetPriorityFeeEstimateForTransaction(ctx, tx, opts)
+			if err != nil {
+				setErr(fmt.Errorf("transaction %d: %w", i, err))
+				return
+			}
+			estimates[i] = *estimate
+		}(i, tx)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return estimates, nil
+}
+
 // CalculatePriorityFee calculates the total priority fee in lamports for a transaction.
 //
 // Formula: priority_fee = (compute_units * micro_lamports_per_cu) / 1_000_000
@@ -219,3 +310,89 @@ func (c *Client) GetPriorityFeeEstimateForTransaction(ctx context.Context, trans
 func CalculatePriorityFee(computeUnits int64, microLamportsPerCU float64) int64 {
 	return int64(float64(computeUnits) * microLamportsPerCU / 1_000_000)
 }
+
+// ComputeUnitPriceForBudget is the inverse of CalculatePriorityFee: it
+// returns the price in microlamports per compute unit at which computeUnits
+// cost lamports in priority fees, for capping the absolute fee spent. It
+// returns 0 if computeUnits is not positive.
+//
+// Formula: micro_lamports_per_cu = lamports * 1_000_000 / compute_units
+//
+// Example:
+//
+//	price := helius.ComputeUnitPriceForBudget(10_000, 200_000) // 50,000 microlamports/CU
+func ComputeUnitPriceForBudget(lamports int64, computeUnits int64) float64 {
+	if computeUnits <= 0 {
+		return 0
+	}
+	return float64(lamports) * 1_000_000 / float64(computeUnits)
+}
+
+// RecommendedPriorityFee estimates the priority fee at level for a
+// transaction touching accountKeys and returns the total fee in lamports for
+// computeUnits, after applying the client's compute-unit buffer (see
+// WithComputeUnitBuffer).
+//
+// Example:
+//
+//	fee, err := client.RecommendedPriorityFee(ctx, accounts, 200_000, helius.PriorityHigh)
+func (c *Client) RecommendedPriorityFee(ctx context.Context, accountKeys []string, computeUnits int64, level PriorityLevel) (int64, error) {
+	if computeUnits <= 0 {
+		return 0, &ValidationError{
+			Field:   "computeUnits",
+			Message: "compute units must be positive",
+		}
+	}
+
+	estimate, err := c.GetPriorityFeeEstimate(ctx, accountKeys, &GetPriorityFeeOptions{
+		PriorityLevel: level,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	buffered := int64(math.Ceil(float64(computeUnits) * (1 + c.cuBuffer)))
+	return CalculatePriorityFee(buffered, estimate.PriorityFeeEstimate), nil
+}
+
+// PriorityFeeTable estimates priority fees at every level for a transaction
+// touching accountKeys, and returns the total fee in lamports at each level
+// for computeUnits, after applying the client's compute-unit buffer (see
+// WithComputeUnitBuffer). It makes a single request.
+//
+// Example:
+//
+//	table, err := client.PriorityFeeTable(ctx, accounts, 200_000)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("medium: %d lamports, high: %d lamports\n", table[helius.PriorityMedium], table[helius.PriorityHigh])
+func (c *Client) PriorityFeeTable(ctx context.Context, accountKeys []string, computeUnits int64) (map[PriorityLevel]int64, error) {
+	if computeUnits <= 0 {
+		return nil, &ValidationError{
+			Field:   "computeUnits",
+			Message: "compute units must be positive",
+		}
+	}
+
+	estimate, err := c.GetPriorityFeeEstimate(ctx, accountKeys, &GetPriorityFeeOptions{
+		IncludeAllPriorityFeeLevels: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	levels := estimate.PriorityFeeLevels
+	if levels == nil {
+		return nil, errors.New("priority fee response has no priorityFeeLevels")
+	}
+
+	buffered := int64(math.Ceil(float64(computeUnits) * (1 + c.cuBuffer)))
+	return map[PriorityLevel]int64{
+		PriorityMin:       CalculatePriorityFee(buffered, levels.Min),
+		PriorityLow:       CalculatePriorityFee(buffered, levels.Low),
+		PriorityMedium:    CalculatePriorityFee(buffered, levels.Medium),
+		PriorityHigh:      CalculatePriorityFee(buffered, levels.High),
+		PriorityVeryHigh:  CalculatePriorityFee(buffered, levels.VeryHigh),
+		PriorityUnsafeMax: CalculatePriorityFee(buffered, levels.UnsafeMax),
+	}, nil
+}
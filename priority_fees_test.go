@@ -3,9 +3,14 @@ package helius
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"math"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestPriorityLevel(t *testing.T) {
@@ -74,6 +79,9 @@ func TestGetPriorityFeeEstimate(t *testing.T) {
 		if err == nil {
 			t.Error("GetPriorityFeeEstimate should return error for empty accountKeys")
 		}
+		if _, ok := IsValidationError(err); !ok {
+			t.Errorf("error should be ValidationError, got %T", err)
+		}
 	})
 
 	t.Run("with priority level option", func(t *testing.T) {
@@ -208,6 +216,25 @@ func TestGetPriorityFeeEstimate(t *testing.T) {
 			t.Fatalf("GetPriorityFeeEstimate returned error: %v", err)
 		}
 	})
+
+	t.Run("json-rpc error with status 200", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"jsonrpc":"2.0","error":{"code":-32602,"message":"Invalid params: lookbackSlots"},"id":"1"}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		_, err := client.GetPriorityFeeEstimate(context.Background(), []string{"some-account"}, nil)
+
+		apiErr, ok := IsAPIError(err)
+		if !ok {
+			t.Fatalf("err = %v, want *APIError", err)
+		}
+		if apiErr.RPCCode != -32602 || apiErr.Message != "Invalid params: lookbackSlots" || apiErr.Path != "/priority-fee" {
+			t.Errorf("APIError = %+v, want RPC error -32602 on /priority-fee", apiErr)
+		}
+	})
 }
 
 func TestGetPriorityFeeEstimateForTransaction(t *testing.T) {
@@ -247,6 +274,9 @@ func TestGetPriorityFeeEstimateForTransaction(t *testing.T) {
 		if err == nil {
 			t.Error("GetPriorityFeeEstimateForTransaction should return error for empty transaction")
 		}
+		if _, ok := IsValidationError(err); !ok {
+			t.Errorf("error should be ValidationError, got %T", err)
+		}
 	})
 
 	t.Run("with encoding option", func(t *testing.T) {
@@ -306,6 +336,121 @@ func TestGetPriorityFeeEstimateForTransaction(t *testing.T) {
 	})
 }
 
+func TestGetPriorityFeeEstimates(t *testing.T) {
+	fees := map[string]float64{"tx-1": 1000, "tx-2": 2000, "tx-3": 3000}
+	delays := map[string]time.Duration{"tx-1": 30 * time.Millisecond, "tx-2": 0, "tx-3": 10 * time.Millisecond}
+
+	t.Run("results in input order", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+			tx, _ := req["transaction"].(string)
+
+			time.Sleep(delays[tx])
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(PriorityFeeEstimate{PriorityFeeEstimate: fees[tx]})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		estimates, err := client.GetPriorityFeeEstimates(context.Background(), []string{"tx-1", "tx-2", "tx-3"}, nil)
+		if err != nil {
+			t.Fatalf("GetPriorityFeeEstimates returned error: %v", err)
+		}
+		if len(estimates) != 3 {
+			t.Fatalf("len(estimates) = %d, want 3", len(estimates))
+		}
+		for i, tx := range []string{"tx-1", "tx-2", "tx-3"} {
+			if estimates[i].PriorityFeeEstimate != fees[tx] {
+				t.Errorf("estimates[%d] = %f, want %f", i, estimates[i].PriorityFeeEstimate, fees[tx])
+			}
+		}
+	})
+
+	t.Run("first error is returned", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+			if req["transaction"] == "tx-2" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(PriorityFeeEstimate{PriorityFeeEstimate: 1})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		_, err := client.GetPriorityFeeEstimates(context.Background(), []string{"tx-1", "tx-2", "tx-3"}, nil)
+		if _, ok := IsAPIError(err); !ok {
+			t.Errorf("err = %v, want APIError", err)
+		}
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(PriorityFeeEstimate{PriorityFeeEstimate: 1})
+		}))
+		defer server.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		_, err := client.GetPriorityFeeEstimates(ctx, []string{"tx-1", "tx-2", "tx-3"}, nil)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("err = %v, want context.Canceled", err)
+		}
+		if n := atomic.LoadInt32(&calls); n != 0 {
+			t.Errorf("server calls = %d, want 0", n)
+		}
+	})
+
+	t.Run("bounded concurrency", func(t *testing.T) {
+		var inFlight, peak int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			json.NewEncoder(w).Encode(PriorityFeeEstimate{PriorityFeeEstimate: 1})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL), WithFanoutConcurrency(2))
+		transactions := []string{"tx-1", "tx-2", "tx-3", "tx-4", "tx-5", "tx-6"}
+		estimates, err := client.GetPriorityFeeEstimates(context.Background(), transactions, nil)
+		if err != nil {
+			t.Fatalf("GetPriorityFeeEstimates returned error: %v", err)
+		}
+		if len(estimates) != len(transactions) {
+			t.Errorf("len(estimates) = %d, want %d", len(estimates), len(transactions))
+		}
+		if peak > 2 {
+			t.Errorf("peak concurrency = %d, want at most 2", peak)
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		client, _ := NewClient("test-key")
+		estimates, err := client.GetPriorityFeeEstimates(context.Background(), nil, nil)
+		if err != nil {
+			t.Fatalf("GetPriorityFeeEstimates returned error: %v", err)
+		}
+		if len(estimates) != 0 {
+			t.Errorf("len(estimates) = %d, want 0", len(estimates))
+		}
+	})
+}
+
 func TestCalculatePriorityFee(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -362,6 +507,274 @@ func TestCalculatePriorityFee(t *testing.T) {
 	}
 }
 
+func TestComputeUnitPriceForBudget(t *testing.T) {
+	tests := []struct {
+		name         string
+		lamports     int64
+		computeUnits int64
+		expected     float64
+	}{
+		{
+			name:         "standard calculation",
+			lamports:     10_000,
+			computeUnits: 200_000,
+			expected:     50_000, // (10000 * 1000000) / 200000 = 50000
+		},
+		{
+			name:         "zero compute units",
+			lamports:     10_000,
+			computeUnits: 0,
+			expected:     0,
+		},
+		{
+			name:         "negative compute units",
+			lamports:     10_000,
+			computeUnits: -1,
+			expected:     0,
+		},
+		{
+			name:         "zero budget",
+			lamports:     0,
+			computeUnits: 200_000,
+			expected:     0,
+		},
+		{
+			name:         "large values",
+			lamports:     140_000,
+			computeUnits: 1_400_000,
+			expected:     100_000, // (140000 * 1000000) / 1400000 = 100000
+		},
+		{
+			name:         "fractional result",
+			lamports:     1,
+			computeUnits: 3_000_000,
+			expected:     1.0 / 3, // (1 * 1000000) / 3000000 = 0.333...
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ComputeUnitPriceForBudget(tt.lamports, tt.computeUnits)
+			if math.Abs(result-tt.expected) > 1e-9 {
+				t.Errorf("ComputeUnitPriceForBudget(%d, %d) = %f, want %f",
+					tt.lamports, tt.computeUnits, result, tt.expected)
+			}
+			if tt.computeUnits > 0 {
+				if fee := CalculatePriorityFee(tt.computeUnits, result); math.Abs(float64(fee-tt.lamports)) > 1 {
+					t.Errorf("CalculatePriorityFee round trip = %d, want about %d", fee, tt.lamports)
+				}
+			}
+		})
+	}
+}
+
+func TestRecommendedPriorityFee(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Options map[string]interface{} `json:"options"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.Options["priorityLevel"] != "High" {
+			t.Errorf("priorityLevel = %v, want High", req.Options["priorityLevel"])
+		}
+		json.NewEncoder(w).Encode(PriorityFeeEstimate{PriorityFeeEstimate: 50_000})
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		buffer   float64
+		expected int64
+	}{
+		{name: "no buffer", buffer: 0, expected: 10_000},    // 200000 * 50000 / 1e6
+		{name: "10% buffer", buffer: 0.1, expected: 11_000}, // 220000 * 50000 / 1e6
+		{name: "50% buffer", buffer: 0.5, expected: 15_000}, // 300000 * 50000 / 1e6
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, _ := NewClient("test-key", WithAPIURL(server.URL), WithComputeUnitBuffer(tt.buffer))
+			fee, err := client.RecommendedPriorityFee(context.Background(), []string{"account-1"}, 200_000, PriorityHigh)
+			if err != nil {
+				t.Fatalf("RecommendedPriorityFee returned error: %v", err)
+			}
+			if fee != tt.expected {
+				t.Errorf("fee = %d, want %d", fee, tt.expected)
+			}
+		})
+	}
+
+	t.Run("non-positive compute units", func(t *testing.T) {
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		_, err := client.RecommendedPriorityFee(context.Background(), []string{"account-1"}, 0, PriorityHigh)
+		if valErr, ok := IsValidationError(err); !ok || valErr.Field != "computeUnits" {
+			t.Errorf("err = %v, want ValidationError for computeUnits", err)
+		}
+	})
+
+	t.Run("invalid buffer", func(t *testing.T) {
+		for _, buffer := range []float64{-1.5, math.NaN(), math.Inf(1)} {
+			_, err := NewClient("test-key", WithComputeUnitBuffer(buffer))
+			if valErr, ok := IsValidationError(err); !ok || valErr.Field != "computeUnitBuffer" {
+				t.Errorf("NewClient(WithComputeUnitBuffer(%v)) err = %v, want ValidationError for computeUnitBuffer", buffer, err)
+			}
+		}
+	})
+}
+
+func TestPriorityFeeTable(t *testing.T) {
+	t.Run("converts each level", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				Options map[string]interface{} `json:"options"`
+			}
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.Options["includeAllPriorityFeeLevels"] != true {
+				t.Errorf("includeAllPriorityFeeLevels = %v, want true", req.Options["includeAllPriorityFeeLevels"])
+			}
+			w.Write([]byte(`{
+				"priorityFeeEstimate": 10000,
+				"priorityFeeLevels": {
+					"min": 0,
+					"low": 1000,
+					"medium": 10000,
+					"high": 50000,
+					"veryHigh": 250000,
+					"unsafeMax": 1000000
+				}
+			}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		table, err := client.PriorityFeeTable(context.Background(), []string{"account-1"}, 200_000)
+		if err != nil {
+			t.Fatalf("PriorityFeeTable returned error: %v", err)
+		}
+
+		want := map[PriorityLevel]int64{
+			PriorityMin:       0,
+			PriorityLow:       200,     // 200000 * 1000 / 1e6
+			PriorityMedium:    2_000,   // 200000 * 10000 / 1e6
+			PriorityHigh:      10_000,  // 200000 * 50000 / 1e6
+			PriorityVeryHigh:  50_000,  // 200000 * 250000 / 1e6
+			PriorityUnsafeMax: 200_000, // 200000 * 1000000 / 1e6
+		}
+		if !reflect.DeepEqual(table, want) {
+			t.Errorf("table = %v, want %v", table, want)
+		}
+	})
+
+	t.Run("missing levels", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"priorityFeeEstimate": 10000}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		if _, err := client.PriorityFeeTable(context.Background(), []string{"account-1"}, 200_000); err == nil {
+			t.Error("expected error for response without priorityFeeLevels")
+		}
+	})
+
+	t.Run("non-positive compute units", func(t *testing.T) {
+		client, _ := NewClient("test-key")
+		_, err := client.PriorityFeeTable(context.Background(), []string{"account-1"}, 0)
+		if valErr, ok := IsValidationError(err); !ok || valErr.Field != "computeUnits" {
+			t.Errorf("err = %v, want ValidationError for computeUnits", err)
+		}
+	})
+}
+
+func TestWithMinPriorityFee(t *testing.T) {
+	newServer := func(response string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(response))
+		}))
+	}
+
+	t.Run("floors a zero estimate", func(t *testing.T) {
+		server := newServer(`{"priorityFeeEstimate": 0, "priorityFeeLevels": {"min": 0, "low": 0, "medium": 800, "high": 5000, "veryHigh": 9000, "unsafeMax": 20000}}`)
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL), WithMinPriorityFee(1000))
+		estimate, err := client.GetPriorityFeeEstimate(context.Background(), []string{"account-1"}, nil)
+		if err != nil {
+			t.Fatalf("GetPriorityFeeEstimate returned error: %v", err)
+		}
+		if estimate.PriorityFeeEstimate != 1000 {
+			t.Errorf("PriorityFeeEstimate = %v, want 1000", estimate.PriorityFeeEstimate)
+		}
+		want := PriorityFeeLevels{Min: 1000, Low: 1000, Medium: 1000, High: 5000, VeryHigh: 9000, UnsafeMax: 20000}
+		if *estimate.PriorityFeeLevels != want {
+			t.Errorf("PriorityFeeLevels = %+v, want %+v", *estimate.PriorityFeeLevels, want)
+		}
+	})
+
+	t.Run("floors a missing estimate for a transaction", func(t *testing.T) {
+		server := newServer(`{}`)
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL), WithMinPriorityFee(1000))
+		estimate, err := client.GetPriorityFeeEstimateForTransaction(context.Background(), "tx", nil)
+		if err != nil {
+			t.Fatalf("GetPriorityFeeEstimateForTransaction returned error: %v", err)
+		}
+		if estimate.PriorityFeeEstimate != 1000 {
+			t.Errorf("PriorityFeeEstimate = %v, want 1000", estimate.PriorityFeeEstimate)
+		}
+	})
+
+	t.Run("leaves a high estimate untouched", func(t *testing.T) {
+		server := newServer(`{"priorityFeeEstimate": 75000}`)
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL), WithMinPriorityFee(1000))
+		estimate, err := client.GetPriorityFeeEstimate(context.Background(), []string{"account-1"}, nil)
+		if err != nil {
+			t.Fatalf("GetPriorityFeeEstimate returned error: %v", err)
+		}
+		if estimate.PriorityFeeEstimate != 75000 {
+			t.Errorf("PriorityFeeEstimate = %v, want 75000", estimate.PriorityFeeEstimate)
+		}
+	})
+
+	t.Run("no floor by default", func(t *testing.T) {
+		server := newServer(`{"priorityFeeEstimate": 0}`)
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		estimate, err := client.GetPriorityFeeEstimate(context.Background(), []string{"account-1"}, nil)
+		if err != nil {
+			t.Fatalf("GetPriorityFeeEstimate returned error: %v", err)
+		}
+		if !estimate.IsZero() {
+			t.Errorf("estimate = %+v, want zero", estimate)
+		}
+	})
+}
+
+func TestPriorityFeeEstimate_IsZero(t *testing.T) {
+	tests := []struct {
+		name     string
+		estimate PriorityFeeEstimate
+		want     bool
+	}{
+		{"empty", PriorityFeeEstimate{}, true},
+		{"zero levels", PriorityFeeEstimate{PriorityFeeLevels: &PriorityFeeLevels{}}, true},
+		{"estimate set", PriorityFeeEstimate{PriorityFeeEstimate: 1}, false},
+		{"level set", PriorityFeeEstimate{PriorityFeeLevels: &PriorityFeeLevels{High: 10}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.estimate.IsZero(); got != tt.want {
+				t.Errorf("IsZero() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestPriorityFeeEstimateTypes(t *testing.T) {
 	t.Run("priority fee estimate", func(t *testing.T) {
 		est := PriorityFeeEstimate{
@@ -0,0 +1,10 @@
+module github.com/Laminar-Bot/helius-go/solana
+
+go 1.21
+
+require (
+	github.com/Laminar-Bot/helius-go v0.0.0
+	github.com/gagliardetto/solana-go v1.12.0
+)
+
+replace github.com/Laminar-Bot/helius-go => ../
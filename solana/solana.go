@@ -0,0 +1,24 @@
+// Package solana connects a helius.Client to github.com/gagliardetto/solana-go
+// for standard Solana RPC calls. It is a module of its own, so that the core
+// helius package does not depend on solana-go.
+//
+// Example:
+//
+//	client, err := helius.NewClient("your-api-key")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	rpcClient := solana.RPCClient(client)
+//	balance, err := rpcClient.GetBalance(ctx, pubkey, rpc.CommitmentFinalized)
+package solana
+
+import (
+	"github.com/Laminar-Bot/helius-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// RPCClient returns a solana-go RPC client for c's Helius RPC endpoint, as
+// returned by RPCURL, so requests carry c's API key and go to its network.
+func RPCClient(c *helius.Client) *rpc.Client {
+	return rpc.New(c.RPCURL())
+}
@@ -0,0 +1,57 @@
+package solana
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Laminar-Bot/helius-go"
+)
+
+func TestRPCClient(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path != "/" {
+			t.Errorf("path = %s, want /", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("api-key"); got != "test-key" {
+			t.Errorf("api-key = %q, want test-key", got)
+		}
+
+		var req map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&req)
+		if req["method"] != "getHealth" {
+			t.Errorf("method = %v, want getHealth", req["method"])
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req["id"],
+			"result":  "ok",
+		})
+	}))
+	defer server.Close()
+
+	client, err := helius.NewClient("test-key", helius.WithRPCURL(server.URL))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	rpcClient := RPCClient(client)
+	if rpcClient == nil {
+		t.Fatal("RPCClient returned nil")
+	}
+
+	health, err := rpcClient.GetHealth(context.Background())
+	if err != nil {
+		t.Fatalf("GetHealth returned error: %v", err)
+	}
+	if health != "ok" {
+		t.Errorf("GetHealth = %q, want ok", health)
+	}
+	if calls != 1 {
+		t.Errorf("server called %d times, want 1 at RPCURL()", calls)
+	}
+}
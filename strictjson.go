@@ -0,0 +1,143 @@
+package helius
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// decode unmarshals a response body into v. With WithStrictJSON, it also
+// fails if the body has fields that v does not model.
+func (c *Client) decode(body []byte, v interface{}) error {
+	if err := json.Unmarshal(body, v); err != nil {
+		return err
+	}
+	if !c.strictJSON {
+		return nil
+	}
+
+	var raw interface{}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return err
+	}
+	return checkUnknownFields(raw, reflect.TypeOf(v), "")
+}
+
+// jsonAliaser is implemented by types whose UnmarshalJSON accepts keys
+// beyond their struct fields' names.
+type jsonAliaser interface {
+	jsonAliases() []string
+}
+
+var (
+	jsonAliaserType = reflect.TypeOf((*jsonAliaser)(nil)).Elem()
+	rawMessageType  = reflect.TypeOf(json.RawMessage(nil))
+)
+
+// checkUnknownFields reports the first object key in raw, a value decoded
+// into interface{}, that has no matching field in type t. Keys match field
+// names case-insensitively, as in encoding/json. Values decoded into
+// interface{} or json.RawMessage are not checked.
+//
+// This is used instead of json.Decoder.DisallowUnknownFields, which does not
+// apply inside types with their own UnmarshalJSON, such as AssetsPage.
+func checkUnknownFields(raw interface{}, t reflect.Type, path string) error {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == rawMessageType {
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := raw.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		fields := jsonFields(t)
+		if reflect.PointerTo(t).Implements(jsonAliaserType) {
+			aliases := reflect.New(t).Interface().(jsonAliaser).jsonAliases()
+			for _, alias := range aliases {
+				fields[strings.ToLower(alias)] = reflect.TypeOf((*interface{})(nil)).Elem()
+			}
+		}
+		for key, value := range obj {
+			fieldType, ok := fields[strings.ToLower(key)]
+			if !ok {
+				return fmt.Errorf("unknown field %q", joinJSONPath(path, key))
+			}
+			if err := checkUnknownFields(value, fieldType, joinJSONPath(path, key)); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		items, ok := raw.([]interface{})
+		if !ok {
+			return nil
+		}
+		for i, item := range items {
+			if err := checkUnknownFields(item, t.Elem(), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		obj, ok := raw.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		for key, value := range obj {
+			if err := checkUnknownFields(value, t.Elem(), joinJSONPath(path, key)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// jsonFields returns the types of the fields encoding/json decodes into for
+// struct type t, keyed by lower-cased JSON name. Fields of embedded structs
+// are included, as encoding/json promotes them.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for k, v := range jsonFields(ft) {
+					if _, ok := fields[k]; !ok {
+						fields[k] = v
+					}
+				}
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[strings.ToLower(name)] = f.Type
+	}
+	return fields
+}
+
+// joinJSONPath appends key to a dotted path.
+func joinJSONPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -4,7 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
 )
 
 // TokenHolder represents a holder of a token.
@@ -38,6 +43,9 @@ type TokenHoldersPage struct {
 }
 
 // GetTokenHoldersOptions configures the token holders request.
+//
+// The token-holders endpoint does not support server-side sorting; use
+// SortHoldersByBalance on the results instead.
 type GetTokenHoldersOptions struct {
 	// Cursor for pagination (from previous response).
 	Cursor string `json:"cursor,omitempty"`
@@ -60,12 +68,14 @@ type GetTokenHoldersOptions struct {
 //	}
 func (c *Client) GetTokenHolders(ctx context.Context, mint string, opts *GetTokenHoldersOptions) (*TokenHoldersPage, error) {
 	if mint == "" {
-		return nil, &APIError{
-			StatusCode: 400,
-			Message:    "mint address is required",
-			Path:       "/token-holders",
+		return nil, &ValidationError{
+			Field:   "mint",
+			Message: "mint address is required",
 		}
 	}
+	if err := c.checkAddress("mint", mint); err != nil {
+		return nil, err
+	}
 
 	reqBody := map[string]interface{}{
 		"mint": mint,
@@ -91,7 +101,7 @@ func (c *Client) GetTokenHolders(ctx context.Context, mint string, opts *GetToke
 	}
 
 	var page TokenHoldersPage
-	if err := json.Unmarshal(body, &page); err != nil {
+	if err := c.decode(body, &page); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 
@@ -106,30 +116,66 @@ func (c *Client) GetTokenHolders(ctx context.Context, mint string, opts *GetToke
 
 // GetAllTokenHolders fetches all holders of a token, handling pagination automatically.
 //
+// A page that fails with a transient error is retried (see WithPageRetries)
+// before giving up.
+//
 // Warning: This can be slow and memory-intensive for tokens with many holders.
 // Consider using GetTokenHolders with pagination for large tokens.
 func (c *Client) GetAllTokenHolders(ctx context.Context, mint string) ([]TokenHolder, error) {
-	var allHolders []TokenHolder
-	var cursor string
+	holders, err := c.GetAllTokenHoldersPartial(ctx, mint)
+	if err != nil {
+		return nil, err
+	}
+	return holders, nil
+}
 
-	for {
-		opts := &GetTokenHoldersOptions{
-			Cursor: cursor,
-			Limit:  10000, // Max per page
-		}
+// GetAllTokenHoldersPartial is like GetAllTokenHolders, but if a page still
+// fails after its retries are exhausted, or the WithMaxPages limit is hit, it
+// returns the holders collected so far along with the error so that progress
+// is not lost.
+func (c *Client) GetAllTokenHoldersPartial(ctx context.Context, mint string) ([]TokenHolder, error) {
+	return c.getAllTokenHolders(ctx, mint, nil)
+}
 
-		page, err := c.GetTokenHolders(ctx, mint, opts)
-		if err != nil {
-			return nil, err
-		}
+// GetAllTokenHoldersWithProgress is like GetAllTokenHolders, but calls onPage
+// after each page is fetched with the number of holders fetched so far and
+// the total reported by that page, e.g. to render a progress bar. onPage is
+// called on the calling goroutine, before the next page is requested.
+//
+// Example:
+//
+//	holders, err := client.GetAllTokenHoldersWithProgress(ctx, mint, func(fetched, total int) {
+//	    fmt.Printf("\r%d/%d holders", fetched, total)
+//	})
+func (c *Client) GetAllTokenHoldersWithProgress(ctx context.Context, mint string, onPage func(fetched, total int)) ([]TokenHolder, error) {
+	holders, err := c.getAllTokenHolders(ctx, mint, onPage)
+	if err != nil {
+		return nil, err
+	}
+	return holders, nil
+}
 
-		allHolders = append(allHolders, page.TokenHolders...)
+// getAllTokenHolders implements GetAllTokenHoldersPartial, calling onPage,
+// if non-nil, after each page.
+func (c *Client) getAllTokenHolders(ctx context.Context, mint string, onPage func(fetched, total int)) ([]TokenHolder, error) {
+	var fetched int
+	p := c.tokenHoldersPaginator(mint, func(page *TokenHoldersPage) {
+		fetched += len(page.TokenHolders)
+		if onPage != nil {
+			onPage(fetched, page.Total)
+		}
+	})
 
-		if page.Cursor == "" || len(page.TokenHolders) == 0 {
+	var allHolders []TokenHolder
+	for {
+		holder, err := p.Next(ctx)
+		if errors.Is(err, ErrIteratorDone) {
 			break
 		}
-
-		cursor = page.Cursor
+		if err != nil {
+			return allHolders, err
+		}
+		allHolders = append(allHolders, holder)
 	}
 
 	c.logger.Info("fetched all token holders",
@@ -140,6 +186,56 @@ func (c *Client) GetAllTokenHolders(ctx context.Context, mint string) ([]TokenHo
 	return allHolders, nil
 }
 
+// SortHoldersByBalance sorts holders in place by Balance, descending if desc
+// is true. Holders with equal balances keep their relative order.
+//
+// The token-holders endpoint returns holders in no particular order, so sort
+// before passing them to CalculateTopHolderStats.
+func SortHoldersByBalance(holders []TokenHolder, desc bool) {
+	sort.SliceStable(holders, func(i, j int) bool {
+		if desc {
+			return holders[i].Balance > holders[j].Balance
+		}
+		return holders[i].Balance < holders[j].Balance
+	})
+}
+
+// FilterHoldersByMinBalance returns the holders whose raw Balance is at
+// least min, preserving order. The token-holders endpoint has no balance
+// filter, so this is applied client-side.
+func FilterHoldersByMinBalance(holders []TokenHolder, min int64) []TokenHolder {
+	var filtered []TokenHolder
+	for _, h := range holders {
+		if h.Balance >= min {
+			filtered = append(filtered, h)
+		}
+	}
+	return filtered
+}
+
+// FilterHoldersByMinUIAmount is like FilterHoldersByMinBalance, but min is in
+// UI units: each holder's Balance is scaled by its Decimals before comparing.
+// The comparison is exact, so a holder of exactly min is always included
+// despite floating-point rounding of min.
+func FilterHoldersByMinUIAmount(holders []TokenHolder, min float64) []TokenHolder {
+	// Parse min from its shortest decimal form, so 0.1 means exactly 1/10
+	// rather than the nearest binary float.
+	threshold, ok := new(big.Rat).SetString(strconv.FormatFloat(min, 'f', -1, 64))
+	if !ok {
+		return nil
+	}
+
+	var filtered []TokenHolder
+	for _, h := range holders {
+		scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(h.Decimals)), nil)
+		amount := new(big.Rat).SetFrac(big.NewInt(h.Balance), scale)
+		if amount.Cmp(threshold) >= 0 {
+			filtered = append(filtered, h)
+		}
+	}
+	return filtered
+}
+
 // TopHolderStats calculates statistics about top token holders.
 type TopHolderStats struct {
 	// TotalHolders is the total number of holders.
@@ -148,18 +244,25 @@ type TopHolderStats struct {
 	// TopHolders is the list of top holders.
 	TopHolders []TokenHolder
 
-	// TopHoldersBalance is the combined balance of top holders.
+	// TopHoldersBalance is the combined balance of top holders, capped at
+	// math.MaxInt64.
 	TopHoldersBalance int64
 
 	// TopHoldersPercent is the percentage of supply held by top holders.
 	TopHoldersPercent float64
 
-	// TotalSupply is the total token supply held by all queried holders.
+	// TotalSupply is the total token supply held by all queried holders,
+	// capped at math.MaxInt64.
 	TotalSupply int64
 }
 
 // CalculateTopHolderStats calculates concentration statistics for token holders.
 //
+// Balances are summed without overflow: if a sum exceeds math.MaxInt64,
+// TotalSupply or TopHoldersBalance is capped at math.MaxInt64, while
+// TopHoldersPercent is still computed from the exact sums. Negative
+// balances, which only come from bad data, are counted as zero.
+//
 // Example:
 //
 //	holders, _ := client.GetTokenHolders(ctx, mint, &helius.GetTokenHoldersOptions{Limit: 100})
@@ -171,10 +274,7 @@ func CalculateTopHolderStats(holders []TokenHolder, topN int) *TopHolderStats {
 	}
 
 	// Calculate total supply
-	var totalSupply int64
-	for _, h := range holders {
-		totalSupply += h.Balance
-	}
+	totalSupply := sumBalances(holders)
 
 	// Get top N holders (assuming sorted by balance descending)
 	topCount := topN
@@ -182,24 +282,153 @@ func CalculateTopHolderStats(holders []TokenHolder, topN int) *TopHolderStats {
 		topCount = len(holders)
 	}
 
-	var topBalance int64
 	topHolders := make([]TokenHolder, topCount)
 	// Copy top holders - bounds are guaranteed by topCount check above
 	copy(topHolders, holders[:topCount])
-	for _, h := range topHolders {
-		topBalance += h.Balance
-	}
+	topBalance := sumBalances(topHolders)
 
 	var topPercent float64
-	if totalSupply > 0 {
-		topPercent = float64(topBalance) / float64(totalSupply) * 100
+	if totalSupply.Sign() > 0 {
+		ratio := new(big.Rat).SetFrac(topBalance, totalSupply)
+		topPercent, _ = ratio.Mul(ratio, big.NewRat(100, 1)).Float64()
 	}
 
 	return &TopHolderStats{
 		TotalHolders:      len(holders),
 		TopHolders:        topHolders,
-		TopHoldersBalance: topBalance,
+		TopHoldersBalance: saturateInt64(topBalance),
 		TopHoldersPercent: topPercent,
-		TotalSupply:       totalSupply,
+		TotalSupply:       saturateInt64(totalSupply),
+	}
+}
+
+// sumBalances returns the exact sum of the holders' positive balances.
+func sumBalances(holders []TokenHolder) *big.Int {
+	sum := new(big.Int)
+	for _, h := range holders {
+		if h.Balance > 0 {
+			sum.Add(sum, big.NewInt(h.Balance))
+		}
+	}
+	return sum
+}
+
+// saturateInt64 returns n as an int64, capped at math.MaxInt64. n must not
+// be negative.
+func saturateInt64(n *big.Int) int64 {
+	if !n.IsInt64() {
+		return math.MaxInt64
+	}
+	return n.Int64()
+}
+
+// TopHolderStatsUI extends TopHolderStats with balances converted to UI
+// amounts, i.e. adjusted for the token's decimals.
+type TopHolderStatsUI struct {
+	TopHolderStats
+
+	// TotalSupplyUI is TotalSupply as a UI amount.
+	TotalSupplyUI float64
+
+	// TopHoldersBalanceUI is TopHoldersBalance as a UI amount.
+	TopHoldersBalanceUI float64
+}
+
+// CalculateTopHolderStatsUI is like CalculateTopHolderStats, but also reports
+// the totals as UI amounts. Each holder's balance is converted with its own
+// Decimals before summing, so holders with missing (zero) decimals count at
+// their raw balance rather than skewing the others.
+//
+// Example:
+//
+//	stats := helius.CalculateTopHolderStatsUI(holders, 10)
+//	fmt.Printf("Top 10 hold %.2f of %.2f tokens\n", stats.TopHoldersBalanceUI, stats.TotalSupplyUI)
+func CalculateTopHolderStatsUI(holders []TokenHolder, topN int) *TopHolderStatsUI {
+	stats := &TopHolderStatsUI{TopHolderStats: *CalculateTopHolderStats(holders, topN)}
+	for _, h := range holders {
+		stats.TotalSupplyUI += uiAmount(h)
+	}
+	for _, h := range stats.TopHolders {
+		stats.TopHoldersBalanceUI += uiAmount(h)
+	}
+	return stats
+}
+
+// uiAmount returns h's balance adjusted for its decimals. Like
+// CalculateTopHolderStats, it counts a negative balance as zero.
+func uiAmount(h TokenHolder) float64 {
+	if h.Balance < 0 {
+		return 0
+	}
+	if h.Decimals <= 0 {
+		return float64(h.Balance)
+	}
+	return float64(h.Balance) / math.Pow10(h.Decimals)
+}
+
+// HolderDistribution counts holders by balance range. buckets are the lower
+// bounds of the ranges, in raw units; for [1, 100, 10000] the result has the
+// keys "1-99", "100-9999" and "10000+", plus "0" for holders with a zero
+// balance. If the lowest bound is above 1, a range from 1 up to it is added,
+// so every holder is counted. Every range is present in the result, with a
+// count of 0 if no holder falls in it.
+//
+// buckets need not be sorted; duplicate and non-positive bounds are ignored.
+// Negative balances, which only come from bad data, count as "0".
+//
+// Example:
+//
+//	holders, _ := client.GetAllTokenHolders(ctx, mint)
+//	dist := helius.HolderDistribution(holders, []int64{1, 100, 10000})
+//	fmt.Printf("%d whales\n", dist["10000+"])
+func HolderDistribution(holders []TokenHolder, buckets []int64) map[string]int {
+	var bounds []int64
+	for _, b := range buckets {
+		if b > 0 {
+			bounds = append(bounds, b)
+		}
+	}
+	sort.Slice(bounds, func(i, j int) bool { return bounds[i] < bounds[j] })
+	bounds = dedupeBounds(bounds)
+	if len(bounds) == 0 || bounds[0] > 1 {
+		bounds = append([]int64{1}, bounds...)
+	}
+
+	labels := make([]string, len(bounds))
+	for i, lo := range bounds {
+		switch {
+		case i == len(bounds)-1:
+			labels[i] = fmt.Sprintf("%d+", lo)
+		case bounds[i+1]-1 == lo:
+			labels[i] = strconv.FormatInt(lo, 10)
+		default:
+			labels[i] = fmt.Sprintf("%d-%d", lo, bounds[i+1]-1)
+		}
+	}
+
+	dist := map[string]int{"0": 0}
+	for _, label := range labels {
+		dist[label] = 0
+	}
+	for _, h := range holders {
+		if h.Balance <= 0 {
+			dist["0"]++
+			continue
+		}
+		// Index of the last bound <= Balance; bounds[0] is 1, so i >= 0.
+		i := sort.Search(len(bounds), func(i int) bool { return bounds[i] > h.Balance }) - 1
+		dist[labels[i]]++
+	}
+	return dist
+}
+
+// dedupeBounds removes adjacent duplicates from sorted bounds in place.
+func dedupeBounds(bounds []int64) []int64 {
+	out := bounds[:0]
+	for i, b := range bounds {
+		if i == 0 || b != bounds[i-1] {
+			out = append(out, b)
+		}
 	}
+	return out
 }
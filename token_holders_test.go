@@ -3,9 +3,14 @@ package helius
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestGetTokenHolders(t *testing.T) {
@@ -59,6 +64,9 @@ func TestGetTokenHolders(t *testing.T) {
 		if err == nil {
 			t.Error("GetTokenHolders should return error for empty mint")
 		}
+		if _, ok := IsValidationError(err); !ok {
+			t.Errorf("error should be ValidationError, got %T", err)
+		}
 	})
 
 	t.Run("with pagination options", func(t *testing.T) {
@@ -211,6 +219,276 @@ func TestGetAllTokenHolders(t *testing.T) {
 	})
 }
 
+func TestGetAllTokenHoldersWithProgress(t *testing.T) {
+	pages := []TokenHoldersPage{
+		{Total: 5, Cursor: "page-2", TokenHolders: []TokenHolder{{Owner: "holder-1"}, {Owner: "holder-2"}}},
+		{Total: 5, Cursor: "page-3", TokenHolders: []TokenHolder{{Owner: "holder-3"}, {Owner: "holder-4"}}},
+		{Total: 5, TokenHolders: []TokenHolder{{Owner: "holder-5"}}},
+	}
+	callCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(pages[callCount])
+		callCount++
+	}))
+	defer server.Close()
+
+	type progress struct{ fetched, total int }
+	var got []progress
+
+	client, _ := NewClient("test-key", WithAPIURL(server.URL))
+	holders, err := client.GetAllTokenHoldersWithProgress(context.Background(), "some-mint", func(fetched, total int) {
+		got = append(got, progress{fetched, total})
+	})
+	if err != nil {
+		t.Fatalf("GetAllTokenHoldersWithProgress returned error: %v", err)
+	}
+	if len(holders) != 5 {
+		t.Errorf("len(holders) = %d, want 5", len(holders))
+	}
+
+	want := []progress{{2, 5}, {4, 5}, {5, 5}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("progress = %v, want %v", got, want)
+	}
+}
+
+func TestGetAllTokenHoldersPageRetries(t *testing.T) {
+	// newServer serves page 1, then fails page 2 with a 500 failures times
+	// before serving it.
+	newServer := func(failures int) (*httptest.Server, *int) {
+		page2Calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+
+			if req["cursor"] == nil {
+				json.NewEncoder(w).Encode(TokenHoldersPage{
+					Cursor:       "page-2",
+					TokenHolders: []TokenHolder{{Owner: "holder-1", Balance: 100}},
+				})
+				return
+			}
+
+			page2Calls++
+			if page2Calls <= failures {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(`{"error": "internal"}`))
+				return
+			}
+			json.NewEncoder(w).Encode(TokenHoldersPage{
+				TokenHolders: []TokenHolder{{Owner: "holder-2", Balance: 50}},
+			})
+		}))
+		return server, &page2Calls
+	}
+
+	newClient := func(t *testing.T, url string, opts ...Option) *Client {
+		t.Helper()
+		opts = append([]Option{WithAPIURL(url), WithMaxRetries(0)}, opts...)
+		client, err := NewClient("test-key", opts...)
+		if err != nil {
+			t.Fatalf("NewClient returned error: %v", err)
+		}
+		client.retryWaitMin = time.Millisecond
+		return client
+	}
+
+	t.Run("retries failed page", func(t *testing.T) {
+		server, page2Calls := newServer(DefaultPageRetries)
+		defer server.Close()
+
+		client := newClient(t, server.URL)
+		holders, err := client.GetAllTokenHolders(context.Background(), "some-mint")
+		if err != nil {
+			t.Fatalf("GetAllTokenHolders returned error: %v", err)
+		}
+		if len(holders) != 2 {
+			t.Errorf("len(holders) = %d, want 2", len(holders))
+		}
+		if *page2Calls != DefaultPageRetries+1 {
+			t.Errorf("page 2 calls = %d, want %d", *page2Calls, DefaultPageRetries+1)
+		}
+	})
+
+	t.Run("partial results after retries exhausted", func(t *testing.T) {
+		server, page2Calls := newServer(100)
+		defer server.Close()
+
+		client := newClient(t, server.URL, WithPageRetries(1))
+		holders, err := client.GetAllTokenHoldersPartial(context.Background(), "some-mint")
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		if len(holders) != 1 || holders[0].Owner != "holder-1" {
+			t.Errorf("holders = %+v, want page 1 only", holders)
+		}
+		if *page2Calls != 2 {
+			t.Errorf("page 2 calls = %d, want 2", *page2Calls)
+		}
+
+		holders, err = client.GetAllTokenHolders(context.Background(), "some-mint")
+		if err == nil {
+			t.Fatal("expected error from GetAllTokenHolders")
+		}
+		if holders != nil {
+			t.Errorf("holders = %+v, want nil", holders)
+		}
+	})
+
+	t.Run("client errors are not retried", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": "bad mint"}`))
+		}))
+		defer server.Close()
+
+		client := newClient(t, server.URL)
+		_, err := client.GetAllTokenHoldersPartial(context.Background(), "some-mint")
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		if calls != 1 {
+			t.Errorf("calls = %d, want 1", calls)
+		}
+	})
+}
+
+func TestGetAllTokenHoldersMaxPages(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		// A misbehaving server that never stops paginating.
+		json.NewEncoder(w).Encode(TokenHoldersPage{
+			Cursor:       fmt.Sprintf("page-%d", calls+1),
+			TokenHolders: []TokenHolder{{Owner: fmt.Sprintf("holder-%d", calls)}},
+		})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-key", WithAPIURL(server.URL), WithMaxPages(3))
+
+	holders, err := client.GetAllTokenHoldersPartial(context.Background(), "some-mint")
+	if !errors.Is(err, ErrMaxPagesExceeded) {
+		t.Fatalf("err = %v, want ErrMaxPagesExceeded", err)
+	}
+	if calls != 3 {
+		t.Errorf("calls = %d, want 3", calls)
+	}
+	if len(holders) != 3 {
+		t.Errorf("len(holders) = %d, want 3", len(holders))
+	}
+
+	holders, err = client.GetAllTokenHolders(context.Background(), "some-mint")
+	if !errors.Is(err, ErrMaxPagesExceeded) || holders != nil {
+		t.Errorf("GetAllTokenHolders = %v, %v, want nil, ErrMaxPagesExceeded", holders, err)
+	}
+}
+
+func TestSortHoldersByBalance(t *testing.T) {
+	newHolders := func() []TokenHolder {
+		return []TokenHolder{
+			{Owner: "a", Balance: 200},
+			{Owner: "b", Balance: 1000},
+			{Owner: "c", Balance: 50},
+			{Owner: "d", Balance: 200},
+		}
+	}
+
+	t.Run("descending", func(t *testing.T) {
+		holders := newHolders()
+		SortHoldersByBalance(holders, true)
+
+		want := []string{"b", "a", "d", "c"}
+		for i, h := range holders {
+			if h.Owner != want[i] {
+				t.Errorf("holders[%d].Owner = %s, want %s", i, h.Owner, want[i])
+			}
+		}
+	})
+
+	t.Run("ascending", func(t *testing.T) {
+		holders := newHolders()
+		SortHoldersByBalance(holders, false)
+
+		want := []string{"c", "a", "d", "b"}
+		for i, h := range holders {
+			if h.Owner != want[i] {
+				t.Errorf("holders[%d].Owner = %s, want %s", i, h.Owner, want[i])
+			}
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		SortHoldersByBalance(nil, true)
+	})
+}
+
+func TestFilterHoldersByMinBalance(t *testing.T) {
+	holders := []TokenHolder{
+		{Owner: "below", Balance: 999},
+		{Owner: "exact", Balance: 1000},
+		{Owner: "above", Balance: 5000},
+	}
+
+	tests := []struct {
+		name string
+		min  int64
+		want []string
+	}{
+		{"boundary included", 1000, []string{"exact", "above"}},
+		{"just above boundary excluded", 1001, []string{"above"}},
+		{"zero keeps all", 0, []string{"below", "exact", "above"}},
+		{"above all", 10000, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, h := range FilterHoldersByMinBalance(holders, tt.min) {
+				got = append(got, h.Owner)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FilterHoldersByMinBalance(%d) = %v, want %v", tt.min, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterHoldersByMinUIAmount(t *testing.T) {
+	holders := []TokenHolder{
+		{Owner: "usdc-below", Balance: 99_999, Decimals: 6},     // 0.099999
+		{Owner: "usdc-exact", Balance: 100_000, Decimals: 6},    // 0.1
+		{Owner: "sol-exact", Balance: 100_000_000, Decimals: 9}, // 0.1
+		{Owner: "sol-above", Balance: 100_000_001, Decimals: 9}, // 0.100000001
+		{Owner: "nft", Balance: 1, Decimals: 0},                 // 1
+	}
+
+	tests := []struct {
+		name string
+		min  float64
+		want []string
+	}{
+		{"inexact float boundary included", 0.1, []string{"usdc-exact", "sol-exact", "sol-above", "nft"}},
+		{"just above boundary excluded", 0.100000001, []string{"sol-above", "nft"}},
+		{"whole units", 1, []string{"nft"}},
+		{"above all", 2, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, h := range FilterHoldersByMinUIAmount(holders, tt.min) {
+				got = append(got, h.Owner)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FilterHoldersByMinUIAmount(%v) = %v, want %v", tt.min, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestCalculateTopHolderStats(t *testing.T) {
 	t.Run("normal case", func(t *testing.T) {
 		holders := []TokenHolder{
@@ -334,6 +612,163 @@ func TestCalculateTopHolderStats(t *testing.T) {
 			t.Errorf("TopHoldersPercent = %f, want 0", stats.TopHoldersPercent)
 		}
 	})
+
+	t.Run("sum overflowing int64", func(t *testing.T) {
+		holders := []TokenHolder{
+			{Owner: "whale-1", Balance: math.MaxInt64},
+			{Owner: "whale-2", Balance: math.MaxInt64},
+			{Owner: "whale-3", Balance: math.MaxInt64},
+			{Owner: "whale-4", Balance: math.MaxInt64},
+		}
+
+		stats := CalculateTopHolderStats(holders, 1)
+
+		if stats.TotalSupply != math.MaxInt64 {
+			t.Errorf("TotalSupply = %d, want math.MaxInt64", stats.TotalSupply)
+		}
+		if stats.TopHoldersBalance != math.MaxInt64 {
+			t.Errorf("TopHoldersBalance = %d, want math.MaxInt64", stats.TopHoldersBalance)
+		}
+		if stats.TopHoldersPercent != 25 {
+			t.Errorf("TopHoldersPercent = %f, want 25", stats.TopHoldersPercent)
+		}
+	})
+
+	t.Run("negative balances count as zero", func(t *testing.T) {
+		holders := []TokenHolder{
+			{Owner: "whale", Balance: 300},
+			{Owner: "fish", Balance: 100},
+			{Owner: "bad-data", Balance: -1000},
+		}
+
+		stats := CalculateTopHolderStats(holders, 1)
+
+		if stats.TotalSupply != 400 {
+			t.Errorf("TotalSupply = %d, want 400", stats.TotalSupply)
+		}
+		if stats.TopHoldersPercent != 75 {
+			t.Errorf("TopHoldersPercent = %f, want 75", stats.TopHoldersPercent)
+		}
+	})
+}
+
+func TestCalculateTopHolderStatsUI(t *testing.T) {
+	t.Run("six decimals", func(t *testing.T) {
+		holders := []TokenHolder{
+			{Owner: "whale-1", Balance: 1_500_000_000, Decimals: 6},
+			{Owner: "whale-2", Balance: 500_000_000, Decimals: 6},
+			{Owner: "user-3", Balance: 250_000, Decimals: 6},
+			{Owner: "user-4", Balance: 1, Decimals: 6},
+		}
+
+		stats := CalculateTopHolderStatsUI(holders, 2)
+
+		if stats.TotalSupply != 2_000_250_001 || stats.TopHoldersBalance != 2_000_000_000 {
+			t.Errorf("raw totals = %d/%d, want 2000250001/2000000000", stats.TotalSupply, stats.TopHoldersBalance)
+		}
+		if math.Abs(stats.TotalSupplyUI-2000.250001) > 1e-9 {
+			t.Errorf("TotalSupplyUI = %f, want 2000.250001", stats.TotalSupplyUI)
+		}
+		if stats.TopHoldersBalanceUI != 2000 {
+			t.Errorf("TopHoldersBalanceUI = %f, want 2000", stats.TopHoldersBalanceUI)
+		}
+		if stats.TotalHolders != 4 || len(stats.TopHolders) != 2 {
+			t.Errorf("TotalHolders/TopHolders = %d/%d, want 4/2", stats.TotalHolders, len(stats.TopHolders))
+		}
+	})
+
+	t.Run("mixed and zero decimals", func(t *testing.T) {
+		holders := []TokenHolder{
+			{Owner: "a", Balance: 2_000_000, Decimals: 6},
+			{Owner: "b", Balance: 300, Decimals: 2},
+			{Owner: "c", Balance: 5},
+		}
+
+		stats := CalculateTopHolderStatsUI(holders, 10)
+
+		if stats.TotalSupplyUI != 10 { // 2 + 3 + 5
+			t.Errorf("TotalSupplyUI = %f, want 10", stats.TotalSupplyUI)
+		}
+		if stats.TopHoldersBalanceUI != stats.TotalSupplyUI {
+			t.Errorf("TopHoldersBalanceUI = %f, want %f", stats.TopHoldersBalanceUI, stats.TotalSupplyUI)
+		}
+	})
+
+	t.Run("empty holders", func(t *testing.T) {
+		stats := CalculateTopHolderStatsUI(nil, 10)
+		if stats.TotalSupplyUI != 0 || stats.TopHoldersBalanceUI != 0 || stats.TotalHolders != 0 {
+			t.Errorf("stats = %+v, want zero", stats)
+		}
+	})
+}
+
+func TestHolderDistribution(t *testing.T) {
+	balances := func(bs ...int64) []TokenHolder {
+		holders := make([]TokenHolder, len(bs))
+		for i, b := range bs {
+			holders[i] = TokenHolder{Owner: fmt.Sprintf("holder-%d", i), Balance: b}
+		}
+		return holders
+	}
+
+	tests := []struct {
+		name    string
+		holders []TokenHolder
+		buckets []int64
+		want    map[string]int
+	}{
+		{
+			name:    "balances on boundaries",
+			holders: balances(0, 1, 99, 100, 9999, 10000, 1_000_000),
+			buckets: []int64{1, 100, 10000},
+			want:    map[string]int{"0": 1, "1-99": 2, "100-9999": 2, "10000+": 2},
+		},
+		{
+			name:    "empty ranges are present",
+			holders: balances(5),
+			buckets: []int64{1, 100, 10000},
+			want:    map[string]int{"0": 0, "1-99": 1, "100-9999": 0, "10000+": 0},
+		},
+		{
+			name:    "unsorted and duplicate buckets",
+			holders: balances(50, 500),
+			buckets: []int64{100, 1, 100},
+			want:    map[string]int{"0": 0, "1-99": 1, "100+": 1},
+		},
+		{
+			name:    "lowest bound above one adds a leading range",
+			holders: balances(1, 9, 10),
+			buckets: []int64{10},
+			want:    map[string]int{"0": 0, "1-9": 2, "10+": 1},
+		},
+		{
+			name:    "single-value range",
+			holders: balances(1, 2),
+			buckets: []int64{1, 2},
+			want:    map[string]int{"0": 0, "1": 1, "2+": 1},
+		},
+		{
+			name:    "negative balance counts as zero",
+			holders: balances(-5, 0),
+			buckets: []int64{1},
+			want:    map[string]int{"0": 2, "1+": 0},
+		},
+		{
+			name:    "no buckets",
+			holders: balances(0, 3),
+			buckets: nil,
+			want:    map[string]int{"0": 1, "1+": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := HolderDistribution(tt.holders, tt.buckets)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("HolderDistribution() = %v, want %v", got, tt.want)
+			}
+		})
+	}
 }
 
 func TestTokenHolderTypes(t *testing.T) {
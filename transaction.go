@@ -0,0 +1,187 @@
+package helius
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// Transaction encodings accepted by GetPriorityFeeOptions.TransactionEncoding.
+const (
+	// TransactionEncodingBase58 is the API's default transaction encoding.
+	TransactionEncodingBase58 = "base58"
+	// TransactionEncodingBase64 is the standard base64 transaction encoding.
+	TransactionEncodingBase64 = "base64"
+)
+
+// Wire-format sizes used when parsing a serialized transaction.
+const (
+	signatureSize       = 64
+	blockhashSize       = 32
+	messageHeaderSize   = 3
+	versionedMessageBit = 0x80
+)
+
+// errShortTransaction is returned when a transaction ends mid-field.
+var errShortTransaction = errors.New("unexpected end of data")
+
+// IsValidTransactionEncoding checks that s decodes with enc ("base58",
+// "base64", or "" for the API default of base58) and parses as a serialized
+// Solana transaction, legacy or versioned. Signatures may be zeroed, so
+// unsigned transactions are accepted.
+//
+// The priority fee API only accepts whole transactions, not bare messages, so
+// this is a cheap way to reject malformed input before calling
+// GetPriorityFeeEstimateForTransaction. It returns a *ValidationError
+// describing the problem.
+func IsValidTransactionEncoding(s, enc string) error {
+	var raw []byte
+	var err error
+	switch enc {
+	case "", TransactionEncodingBase58:
+		raw, err = decodeBase58(s)
+	case TransactionEncodingBase64:
+		raw, err = base64.StdEncoding.DecodeString(s)
+	default:
+		return &ValidationError{
+			Field:   "transactionEncoding",
+			Message: fmt.Sprintf("unsupported encoding %q", enc),
+		}
+	}
+	if err != nil {
+		return &ValidationError{Field: "transaction", Message: err.Error()}
+	}
+	if err := parseTransaction(raw); err != nil {
+		return &ValidationError{Field: "transaction", Message: err.Error()}
+	}
+	return nil
+}
+
+// parseTransaction walks the wire format of a serialized transaction and
+// reports the first structural problem found.
+func parseTransaction(raw []byte) error {
+	r := &txReader{buf: raw}
+
+	numSigs, err := r.compactU16()
+	if err != nil {
+		return fmt.Errorf("signature count: %w", err)
+	}
+	if numSigs == 0 {
+		return errors.New("transaction has no signatures")
+	}
+	if err := r.skip(numSigs * signatureSize); err != nil {
+		return fmt.Errorf("signatures: %w", err)
+	}
+
+	versioned := false
+	if len(r.buf) > r.pos && r.buf[r.pos]&versionedMessageBit != 0 {
+		if version := r.buf[r.pos] &^ versionedMessageBit; version != 0 {
+			return fmt.Errorf("unsupported message version %d", version)
+		}
+		versioned = true
+		r.pos++
+	}
+
+	header, err := r.bytes(messageHeaderSize)
+	if err != nil {
+		return fmt.Errorf("message header: %w", err)
+	}
+	if int(header[0]) != numSigs {
+		return fmt.Errorf("message requires %d signatures, transaction has %d", header[0], numSigs)
+	}
+
+	numKeys, err := r.compactU16()
+	if err != nil {
+		return fmt.Errorf("account key count: %w", err)
+	}
+	if err := r.skip(numKeys * publicKeySize); err != nil {
+		return fmt.Errorf("account keys: %w", err)
+	}
+	if err := r.skip(blockhashSize); err != nil {
+		return fmt.Errorf("recent blockhash: %w", err)
+	}
+
+	numInstructions, err := r.compactU16()
+	if err != nil {
+		return fmt.Errorf("instruction count: %w", err)
+	}
+	for i := 0; i < numInstructions; i++ {
+		if err := r.skip(1); err != nil { // program ID index
+			return fmt.Errorf("instruction %d: %w", i, err)
+		}
+		if err := r.skipCompactArray(); err != nil { // account indexes
+			return fmt.Errorf("instruction %d accounts: %w", i, err)
+		}
+		if err := r.skipCompactArray(); err != nil { // data
+			return fmt.Errorf("instruction %d data: %w", i, err)
+		}
+	}
+
+	if versioned {
+		numLookups, err := r.compactU16()
+		if err != nil {
+			return fmt.Errorf("address table lookup count: %w", err)
+		}
+		for i := 0; i < numLookups; i++ {
+			if err := r.skip(publicKeySize); err != nil {
+				return fmt.Errorf("address table lookup %d: %w", i, err)
+			}
+			if err := r.skipCompactArray(); err != nil { // writable indexes
+				return fmt.Errorf("address table lookup %d: %w", i, err)
+			}
+			if err := r.skipCompactArray(); err != nil { // readonly indexes
+				return fmt.Errorf("address table lookup %d: %w", i, err)
+			}
+		}
+	}
+
+	if r.pos != len(r.buf) {
+		return fmt.Errorf("%d trailing bytes after message", len(r.buf)-r.pos)
+	}
+	return nil
+}
+
+// txReader is a cursor over a serialized transaction.
+type txReader struct {
+	buf []byte
+	pos int
+}
+
+// compactU16 reads Solana's variable-length "shortvec" length prefix.
+func (r *txReader) compactU16() (int, error) {
+	var v int
+	for i := 0; i < 3; i++ {
+		if r.pos >= len(r.buf) {
+			return 0, errShortTransaction
+		}
+		b := r.buf[r.pos]
+		r.pos++
+		v |= int(b&0x7f) << (7 * i)
+		if b&0x80 == 0 {
+			return v, nil
+		}
+	}
+	return 0, errors.New("compact-u16 length overflows")
+}
+
+func (r *txReader) bytes(n int) ([]byte, error) {
+	if n > len(r.buf)-r.pos {
+		return nil, errShortTransaction
+	}
+	b := r.buf[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+func (r *txReader) skip(n int) error {
+	_, err := r.bytes(n)
+	return err
+}
+
+func (r *txReader) skipCompactArray() error {
+	n, err := r.compactU16()
+	if err != nil {
+		return err
+	}
+	return r.skip(n)
+}
@@ -0,0 +1,143 @@
+package helius
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// testTransaction builds a minimal serialized transaction with one zeroed
+// signature, two account keys, and one instruction.
+func testTransaction(versioned bool) []byte {
+	var b bytes.Buffer
+	b.WriteByte(1)                       // signature count
+	b.Write(make([]byte, signatureSize)) // signature
+	if versioned {
+		b.WriteByte(versionedMessageBit) // version 0
+	}
+	b.Write([]byte{1, 0, 1})                   // header
+	b.WriteByte(2)                             // account key count
+	b.Write(make([]byte, 2*publicKeySize))     // account keys
+	b.Write(make([]byte, blockhashSize))       // recent blockhash
+	b.Write([]byte{1, 1, 1, 0, 2, 0xaa, 0xbb}) // one instruction
+	if versioned {
+		b.WriteByte(0) // address table lookups
+	}
+	return b.Bytes()
+}
+
+// encodeBase58 is the test-only inverse of decodeBase58.
+func encodeBase58(b []byte) string {
+	n := new(big.Int).SetBytes(b)
+	base := big.NewInt(58)
+	mod := new(big.Int)
+	var out []byte
+	for n.Sign() > 0 {
+		n.DivMod(n, base, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+	for _, c := range b {
+		if c != 0 {
+			break
+		}
+		out = append(out, base58Alphabet[0])
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out)
+}
+
+func TestIsValidTransactionEncoding(t *testing.T) {
+	legacy := testTransaction(false)
+	versioned := testTransaction(true)
+
+	tests := []struct {
+		name    string
+		input   string
+		enc     string
+		wantErr string
+	}{
+		{name: "legacy base64", input: base64.StdEncoding.EncodeToString(legacy), enc: "base64"},
+		{name: "versioned base64", input: base64.StdEncoding.EncodeToString(versioned), enc: "base64"},
+		{name: "legacy base58", input: encodeBase58(legacy), enc: "base58"},
+		{name: "default encoding is base58", input: encodeBase58(versioned), enc: ""},
+		{name: "invalid base64", input: "not base64!", enc: "base64", wantErr: "illegal base64"},
+		{name: "invalid base58", input: "0OIl", enc: "base58", wantErr: "invalid base58 character"},
+		{name: "unsupported encoding", input: "abc", enc: "hex", wantErr: "unsupported encoding"},
+		{
+			name:    "truncated",
+			input:   base64.StdEncoding.EncodeToString(legacy[:len(legacy)-3]),
+			enc:     "base64",
+			wantErr: "unexpected end of data",
+		},
+		{
+			name:    "trailing bytes",
+			input:   base64.StdEncoding.EncodeToString(append(append([]byte{}, legacy...), 0)),
+			enc:     "base64",
+			wantErr: "trailing bytes",
+		},
+		{
+			name:    "no signatures",
+			input:   base64.StdEncoding.EncodeToString([]byte{0}),
+			enc:     "base64",
+			wantErr: "no signatures",
+		},
+		{
+			name:    "message only",
+			input:   base64.StdEncoding.EncodeToString(legacy[1+signatureSize:]),
+			enc:     "base64",
+			wantErr: "signatures",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := IsValidTransactionEncoding(tt.input, tt.enc)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("IsValidTransactionEncoding returned error: %v", err)
+				}
+				return
+			}
+			if _, ok := IsValidationError(err); !ok {
+				t.Fatalf("err = %v, want ValidationError", err)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("err = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetPriorityFeeEstimateForTransaction_Strict(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{"priorityFeeEstimate": 1000}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-key", WithAPIURL(server.URL), WithStrictValidation(true))
+	opts := &GetPriorityFeeOptions{TransactionEncoding: TransactionEncodingBase64}
+
+	if _, err := client.GetPriorityFeeEstimateForTransaction(context.Background(), "AAAA", opts); err == nil {
+		t.Error("expected error for malformed transaction")
+	}
+	if calls != 0 {
+		t.Errorf("calls = %d, want 0", calls)
+	}
+
+	tx := base64.StdEncoding.EncodeToString(testTransaction(false))
+	if _, err := client.GetPriorityFeeEstimateForTransaction(context.Background(), tx, opts); err != nil {
+		t.Fatalf("GetPriorityFeeEstimateForTransaction returned error: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+}
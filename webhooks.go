@@ -2,12 +2,37 @@ package helius
 
 import (
 	"bytes"
+	"container/list"
 	"context"
 	"crypto/hmac"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// WebhookSignatureHeader is the header carrying the webhook HMAC signature.
+	WebhookSignatureHeader = "X-Helius-Signature"
+
+	// maxWebhookBodySize caps the payload size accepted by WebhookHandler.
+	maxWebhookBodySize = 10 << 20 // 10 MiB
+
+	// MaxWebhookAddresses is the most account addresses a single webhook can
+	// monitor.
+	MaxWebhookAddresses = 10000
+
+	// DefaultDeduperCapacity is the default number of signatures remembered
+	// by a WebhookDeduper.
+	DefaultDeduperCapacity = 10000
 )
 
 // WebhookType represents the type of webhook.
@@ -86,34 +111,44 @@ type CreateWebhookRequest struct {
 	AuthHeader string `json:"authHeader,omitempty"`
 }
 
+// ToCreateRequest returns a CreateWebhookRequest with the same configuration
+// as w, for cloning a webhook (e.g., from staging to production).
+//
+// Slices are copied, so modifying the request does not affect w.
+func (w *Webhook) ToCreateRequest() *CreateWebhookRequest {
+	return &CreateWebhookRequest{
+		WebhookURL:       w.WebhookURL,
+		TransactionTypes: append([]TransactionType(nil), w.TransactionTypes...),
+		AccountAddresses: append([]string(nil), w.AccountAddresses...),
+		WebhookType:      w.WebhookType,
+		AuthHeader:       w.AuthHeader,
+	}
+}
+
 // CreateWebhook creates a new webhook for monitoring transactions.
 func (c *Client) CreateWebhook(ctx context.Context, req *CreateWebhookRequest) (*Webhook, error) {
 	if req == nil {
-		return nil, &APIError{
-			StatusCode: 400,
-			Message:    "request is required",
-			Path:       "/webhooks",
+		return nil, &ValidationError{
+			Field:   "req",
+			Message: "request is required",
 		}
 	}
 	if req.WebhookURL == "" {
-		return nil, &APIError{
-			StatusCode: 400,
-			Message:    "webhookURL is required",
-			Path:       "/webhooks",
+		return nil, &ValidationError{
+			Field:   "webhookURL",
+			Message: "webhookURL is required",
 		}
 	}
 	if len(req.TransactionTypes) == 0 {
-		return nil, &APIError{
-			StatusCode: 400,
-			Message:    "at least one transactionType is required",
-			Path:       "/webhooks",
+		return nil, &ValidationError{
+			Field:   "transactionTypes",
+			Message: "at least one transactionType is required",
 		}
 	}
 	if len(req.AccountAddresses) == 0 {
-		return nil, &APIError{
-			StatusCode: 400,
-			Message:    "at least one accountAddress is required",
-			Path:       "/webhooks",
+		return nil, &ValidationError{
+			Field:   "accountAddresses",
+			Message: "at least one accountAddress is required",
 		}
 	}
 
@@ -133,7 +168,7 @@ func (c *Client) CreateWebhook(ctx context.Context, req *CreateWebhookRequest) (
 	}
 
 	var webhook Webhook
-	if err := json.Unmarshal(body, &webhook); err != nil {
+	if err := c.decode(body, &webhook); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 
@@ -146,13 +181,61 @@ func (c *Client) CreateWebhook(ctx context.Context, req *CreateWebhookRequest) (
 	return &webhook, nil
 }
 
+// CreateWebhooksSharded creates as many webhooks as needed to monitor all of
+// req.AccountAddresses, splitting them into chunks of MaxWebhookAddresses.
+// Every webhook gets the same URL, transaction types, type, and auth header,
+// and they are returned in the order of the chunks.
+//
+// Creation is all-or-nothing on a best-effort basis: if any create fails, the
+// webhooks already created are deleted again, and nil is returned with an
+// error joining the create failure and any failed deletes. Rollback runs even
+// if ctx has been cancelled.
+func (c *Client) CreateWebhooksSharded(ctx context.Context, req *CreateWebhookRequest) ([]*Webhook, error) {
+	if req == nil {
+		return nil, &ValidationError{
+			Field:   "req",
+			Message: "request is required",
+		}
+	}
+	if len(req.AccountAddresses) == 0 {
+		return nil, &ValidationError{
+			Field:   "accountAddresses",
+			Message: "at least one accountAddress is required",
+		}
+	}
+
+	var created []*Webhook
+	for start := 0; start < len(req.AccountAddresses); start += MaxWebhookAddresses {
+		end := start + MaxWebhookAddresses
+		if end > len(req.AccountAddresses) {
+			end = len(req.AccountAddresses)
+		}
+
+		shard := *req
+		shard.AccountAddresses = req.AccountAddresses[start:end]
+		webhook, err := c.CreateWebhook(ctx, &shard)
+		if err != nil {
+			errs := []error{fmt.Errorf("create webhook for addresses %d-%d: %w", start, end-1, err)}
+			rollbackCtx := context.WithoutCancel(ctx)
+			for _, w := range created {
+				if err := c.DeleteWebhook(rollbackCtx, w.WebhookID); err != nil {
+					errs = append(errs, fmt.Errorf("roll back webhook %s: %w", w.WebhookID, err))
+				}
+			}
+			return nil, errors.Join(errs...)
+		}
+		created = append(created, webhook)
+	}
+
+	return created, nil
+}
+
 // GetWebhook fetches a webhook by its ID.
 func (c *Client) GetWebhook(ctx context.Context, webhookID string) (*Webhook, error) {
 	if webhookID == "" {
-		return nil, &APIError{
-			StatusCode: 400,
-			Message:    "webhookID is required",
-			Path:       "/webhooks",
+		return nil, &ValidationError{
+			Field:   "webhookID",
+			Message: "webhookID is required",
 		}
 	}
 
@@ -163,7 +246,7 @@ func (c *Client) GetWebhook(ctx context.Context, webhookID string) (*Webhook, er
 	}
 
 	var webhook Webhook
-	if err := json.Unmarshal(body, &webhook); err != nil {
+	if err := c.decode(body, &webhook); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 
@@ -178,7 +261,7 @@ func (c *Client) ListWebhooks(ctx context.Context) ([]Webhook, error) {
 	}
 
 	var webhooks []Webhook
-	if err := json.Unmarshal(body, &webhooks); err != nil {
+	if err := c.decode(body, &webhooks); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 
@@ -208,17 +291,15 @@ type UpdateWebhookRequest struct {
 // UpdateWebhook updates an existing webhook.
 func (c *Client) UpdateWebhook(ctx context.Context, webhookID string, req *UpdateWebhookRequest) (*Webhook, error) {
 	if webhookID == "" {
-		return nil, &APIError{
-			StatusCode: 400,
-			Message:    "webhookID is required",
-			Path:       "/webhooks",
+		return nil, &ValidationError{
+			Field:   "webhookID",
+			Message: "webhookID is required",
 		}
 	}
 	if req == nil {
-		return nil, &APIError{
-			StatusCode: 400,
-			Message:    "request is required",
-			Path:       "/webhooks",
+		return nil, &ValidationError{
+			Field:   "req",
+			Message: "request is required",
 		}
 	}
 
@@ -235,7 +316,7 @@ func (c *Client) UpdateWebhook(ctx context.Context, webhookID string, req *Updat
 	}
 
 	var webhook Webhook
-	if err := json.Unmarshal(body, &webhook); err != nil {
+	if err := c.decode(body, &webhook); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 
@@ -244,13 +325,180 @@ func (c *Client) UpdateWebhook(ctx context.Context, webhookID string, req *Updat
 	return &webhook, nil
 }
 
+// EnsureWebhook makes sure a webhook with the settings in req exists, so
+// webhook configuration can be applied declaratively and re-run safely.
+//
+// Webhooks are matched on WebhookURL. If none matches, one is created as by
+// CreateWebhook. If one does, it is updated to the requested transaction
+// types, addresses, webhook type and auth header, unless it already has them,
+// in which case it is returned without a write. Types and addresses are
+// compared as sets, ignoring order. Empty TransactionTypes, AccountAddresses
+// or AuthHeader leave the existing values in place, since an update cannot
+// clear them. If several webhooks share the URL, the first one listed is used.
+func (c *Client) EnsureWebhook(ctx context.Context, req *CreateWebhookRequest) (*Webhook, error) {
+	if req == nil {
+		return nil, &ValidationError{
+			Field:   "req",
+			Message: "request is required",
+		}
+	}
+	if req.WebhookURL == "" {
+		return nil, &ValidationError{
+			Field:   "webhookURL",
+			Message: "webhookURL is required",
+		}
+	}
+
+	webhooks, err := c.ListWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing *Webhook
+	for i := range webhooks {
+		if webhooks[i].WebhookURL == req.WebhookURL {
+			existing = &webhooks[i]
+			break
+		}
+	}
+	if existing == nil {
+		return c.CreateWebhook(ctx, req)
+	}
+
+	webhookType := req.WebhookType
+	if webhookType == "" {
+		webhookType = WebhookTypeEnhanced
+	}
+
+	// An update cannot clear a field, as empty fields are omitted from it,
+	// so fields left empty in req are compared as they currently are.
+	desired := &Webhook{
+		WebhookURL:       req.WebhookURL,
+		TransactionTypes: req.TransactionTypes,
+		AccountAddresses: req.AccountAddresses,
+		WebhookType:      webhookType,
+		AuthHeader:       req.AuthHeader,
+	}
+	if len(desired.TransactionTypes) == 0 {
+		desired.TransactionTypes = existing.TransactionTypes
+	}
+	if len(desired.AccountAddresses) == 0 {
+		desired.AccountAddresses = existing.AccountAddresses
+	}
+	if desired.AuthHeader == "" {
+		desired.AuthHeader = existing.AuthHeader
+	}
+
+	diff := existing.Diff(desired)
+	if !diff.HasChanges() {
+		c.logger.Debug("webhook up to date", "webhookID", existing.WebhookID)
+		return existing, nil
+	}
+
+	c.logger.Debug("updating webhook",
+		"webhookID", existing.WebhookID,
+		"addAddresses", len(diff.AddedAddresses),
+		"removeAddresses", len(diff.RemovedAddresses),
+	)
+
+	return c.UpdateWebhook(ctx, existing.WebhookID, &UpdateWebhookRequest{
+		WebhookURL:       req.WebhookURL,
+		TransactionTypes: req.TransactionTypes,
+		AccountAddresses: req.AccountAddresses,
+		WebhookType:      webhookType,
+		AuthHeader:       req.AuthHeader,
+	})
+}
+
+// WebhookDiff describes the changes that turn one webhook configuration into
+// another, as reported by Webhook.Diff. Its zero value means no changes.
+type WebhookDiff struct {
+	// AddedAddresses are the account addresses only in the new configuration.
+	AddedAddresses []string
+
+	// RemovedAddresses are the account addresses only in the old configuration.
+	RemovedAddresses []string
+
+	// AddedTransactionTypes are the transaction types only in the new
+	// configuration.
+	AddedTransactionTypes []TransactionType
+
+	// RemovedTransactionTypes are the transaction types only in the old
+	// configuration.
+	RemovedTransactionTypes []TransactionType
+
+	// URLChanged reports whether WebhookURL differs.
+	URLChanged bool
+
+	// WebhookTypeChanged reports whether WebhookType differs.
+	WebhookTypeChanged bool
+
+	// AuthHeaderChanged reports whether AuthHeader differs.
+	AuthHeaderChanged bool
+}
+
+// HasChanges reports whether d describes any change.
+func (d WebhookDiff) HasChanges() bool {
+	return len(d.AddedAddresses) > 0 || len(d.RemovedAddresses) > 0 ||
+		len(d.AddedTransactionTypes) > 0 || len(d.RemovedTransactionTypes) > 0 ||
+		d.URLChanged || d.WebhookTypeChanged || d.AuthHeaderChanged
+}
+
+// Diff reports the changes from w to other, e.g. from a webhook's current
+// configuration to the desired one. Addresses and transaction types are
+// compared as sets: order and duplicates are ignored, and added or removed
+// entries are listed in the order they appear. WebhookID and Wallet are not
+// compared. A nil webhook is treated as an empty configuration.
+//
+// Example:
+//
+//	diff := current.Diff(desired)
+//	fmt.Printf("will add %d addresses, remove %d\n", len(diff.AddedAddresses), len(diff.RemovedAddresses))
+func (w *Webhook) Diff(other *Webhook) WebhookDiff {
+	if w == nil {
+		w = &Webhook{}
+	}
+	if other == nil {
+		other = &Webhook{}
+	}
+
+	var d WebhookDiff
+	d.AddedAddresses, d.RemovedAddresses = setDiff(w.AccountAddresses, other.AccountAddresses)
+	d.AddedTransactionTypes, d.RemovedTransactionTypes = setDiff(w.TransactionTypes, other.TransactionTypes)
+	d.URLChanged = w.WebhookURL != other.WebhookURL
+	d.WebhookTypeChanged = w.WebhookType != other.WebhookType
+	d.AuthHeaderChanged = w.AuthHeader != other.AuthHeader
+	return d
+}
+
+// setDiff returns the distinct elements only in to, and only in from, each
+// in order of first appearance.
+func setDiff[T comparable](from, to []T) (added, removed []T) {
+	return missingFrom(to, from), missingFrom(from, to)
+}
+
+// missingFrom returns the distinct elements of a that are not in b.
+func missingFrom[T comparable](a, b []T) []T {
+	seen := make(map[T]bool, len(a)+len(b))
+	for _, v := range b {
+		seen[v] = true
+	}
+	var missing []T
+	for _, v := range a {
+		if !seen[v] {
+			seen[v] = true
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
 // DeleteWebhook deletes a webhook.
 func (c *Client) DeleteWebhook(ctx context.Context, webhookID string) error {
 	if webhookID == "" {
-		return &APIError{
-			StatusCode: 400,
-			Message:    "webhookID is required",
-			Path:       "/webhooks",
+		return &ValidationError{
+			Field:   "webhookID",
+			Message: "webhookID is required",
 		}
 	}
 
@@ -266,10 +514,35 @@ func (c *Client) DeleteWebhook(ctx context.Context, webhookID string) error {
 	return nil
 }
 
+// DeleteAllWebhooks deletes every webhook on the account and returns how many
+// were deleted. It is meant for cleaning up test environments.
+//
+// A failed delete does not stop the others: the failures are combined with
+// errors.Join and returned along with the count of successful deletes.
+func (c *Client) DeleteAllWebhooks(ctx context.Context) (int, error) {
+	webhooks, err := c.ListWebhooks(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var deleted int
+	var errs []error
+	for _, webhook := range webhooks {
+		if err := c.DeleteWebhook(ctx, webhook.WebhookID); err != nil {
+			errs = append(errs, fmt.Errorf("delete webhook %s: %w", webhook.WebhookID, err))
+			continue
+		}
+		deleted++
+	}
+
+	return deleted, errors.Join(errs...)
+}
+
 // ValidateWebhookSignature validates the HMAC signature of a webhook payload.
 //
 // This should be called for every incoming webhook to verify authenticity.
-// The signature is typically passed in the X-Helius-Signature header.
+// The signature is typically passed in the X-Helius-Signature header as
+// hex, optionally prefixed with "sha256=".
 //
 // Example:
 //
@@ -284,16 +557,80 @@ func (c *Client) DeleteWebhook(ctx context.Context, webhookID string) error {
 //	    // Process webhook...
 //	}
 func ValidateWebhookSignature(body []byte, signature string, secret string) bool {
+	return ValidateWebhookSignatureEnc(body, signature, secret, SignatureEncodingHex)
+}
+
+// ValidateWebhookSignatureAny reports whether signature is valid for body
+// under any of secrets. Empty secrets are skipped.
+//
+// This supports rotating a webhook secret without dropping deliveries: accept
+// both the old and new secrets until the rotation has propagated. Every
+// secret is checked, so the time taken does not reveal which one matched.
+func ValidateWebhookSignatureAny(body []byte, signature string, secrets ...string) bool {
+	valid := false
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		if ValidateWebhookSignature(body, signature, secret) {
+			valid = true
+		}
+	}
+	return valid
+}
+
+// SignatureEncoding is the text encoding of a webhook HMAC signature.
+type SignatureEncoding int
+
+const (
+	// SignatureEncodingHex is a hex-encoded signature (the Helius default).
+	SignatureEncodingHex SignatureEncoding = iota
+	// SignatureEncodingBase64 is a standard base64-encoded signature.
+	SignatureEncodingBase64
+)
+
+// ValidateWebhookSignatureEnc validates the HMAC signature of a webhook
+// payload whose signature is encoded with enc.
+//
+// Use this when a proxy re-encodes the signature, e.g. as base64. The
+// signature is decoded before a constant-time comparison; a signature that
+// fails to decode is reported as invalid.
+func ValidateWebhookSignatureEnc(body []byte, signature, secret string, enc SignatureEncoding) bool {
+	signature = trimSignaturePrefix(signature)
 	if signature == "" || secret == "" {
 		return false
 	}
 
+	var got []byte
+	var err error
+	switch enc {
+	case SignatureEncodingHex:
+		got, err = hex.DecodeString(signature)
+	case SignatureEncodingBase64:
+		got, err = base64.StdEncoding.DecodeString(signature)
+	default:
+		return false
+	}
+	if err != nil {
+		return false
+	}
+
 	h := hmac.New(sha256.New, []byte(secret))
 	h.Write(body)
-	expectedSignature := hex.EncodeToString(h.Sum(nil))
 
 	// Use constant-time comparison to prevent timing attacks
-	return hmac.Equal([]byte(expectedSignature), []byte(signature))
+	return hmac.Equal(h.Sum(nil), got)
+}
+
+// signaturePrefix is the optional algorithm prefix on webhook signatures.
+const signaturePrefix = "sha256="
+
+// trimSignaturePrefix strips a case-insensitive "sha256=" prefix.
+func trimSignaturePrefix(signature string) string {
+	if len(signature) >= len(signaturePrefix) && strings.EqualFold(signature[:len(signaturePrefix)], signaturePrefix) {
+		return signature[len(signaturePrefix):]
+	}
+	return signature
 }
 
 // WebhookEvent represents an incoming webhook event.
@@ -336,6 +673,66 @@ type WebhookEvent struct {
 
 	// Type is the transaction type (e.g., "SWAP").
 	Type string `json:"type,omitempty"`
+
+	raw json.RawMessage
+}
+
+// UnmarshalJSON decodes the event and keeps a copy of the original bytes,
+// available through Raw. Fee, Slot, and Timestamp are accepted as JSON
+// numbers or as strings, since some webhook versions send them quoted.
+func (e *WebhookEvent) UnmarshalJSON(data []byte) error {
+	type plain WebhookEvent
+	var p plain
+	aux := struct {
+		*plain
+		Fee       flexInt64 `json:"fee"`
+		Slot      flexInt64 `json:"slot"`
+		Timestamp flexInt64 `json:"timestamp"`
+	}{plain: &p}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	*e = WebhookEvent(p)
+	e.Fee = int64(aux.Fee)
+	e.Slot = int64(aux.Slot)
+	e.Timestamp = int64(aux.Timestamp)
+	e.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// flexInt64 is an int64 that decodes from a JSON number or a string holding
+// one.
+type flexInt64 int64
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *flexInt64) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+		if s == "" {
+			*n = 0
+			return nil
+		}
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid integer %s", data)
+	}
+	*n = flexInt64(v)
+	return nil
+}
+
+// Raw returns the original JSON the event was decoded from, or nil if the
+// event was not decoded from JSON. When the event came from an array payload,
+// Raw returns only that element's bytes.
+//
+// Raw is useful for forwarding the exact payload after inspecting it, since
+// Events and Instructions do not round-trip losslessly through json.Marshal.
+func (e *WebhookEvent) Raw() json.RawMessage {
+	return e.raw
 }
 
 // AccountData represents account data changes.
@@ -377,12 +774,112 @@ type TokenTransfer struct {
 	TokenStandard    string  `json:"tokenStandard,omitempty"`
 }
 
+// Instruction is an instruction in an enhanced webhook event.
+type Instruction struct {
+	// Accounts lists the addresses passed to the instruction.
+	Accounts []string `json:"accounts"`
+
+	// Data is the base58-encoded instruction data.
+	Data string `json:"data"`
+
+	// ProgramID is the address of the invoked program.
+	ProgramID string `json:"programId"`
+
+	// InnerInstructions lists the instructions invoked by this one through
+	// cross-program invocation.
+	InnerInstructions []Instruction `json:"innerInstructions,omitempty"`
+}
+
+// ParsedInstructions decodes Instructions into typed values. Instructions
+// itself is left untouched, so fields not modeled by Instruction remain
+// available there.
+//
+// Example:
+//
+//	instructions, err := event.ParsedInstructions()
+//	for _, ix := range instructions {
+//	    if ix.ProgramID == jupiterProgramID {
+//	        // ...
+//	    }
+//	}
+func (e *WebhookEvent) ParsedInstructions() ([]Instruction, error) {
+	if len(e.Instructions) == 0 {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(e.Instructions)
+	if err != nil {
+		return nil, fmt.Errorf("marshal instructions: %w", err)
+	}
+
+	var instructions []Instruction
+	if err := json.Unmarshal(data, &instructions); err != nil {
+		return nil, fmt.Errorf("decode instructions: %w", err)
+	}
+	return instructions, nil
+}
+
+// CompressedNFTEvent is a compressed NFT event from the "compressed" list in
+// an enhanced webhook event's Events, such as a mint or transfer of a leaf.
+type CompressedNFTEvent struct {
+	// Type is the event type, e.g. "COMPRESSED_NFT_MINT" or
+	// "COMPRESSED_NFT_TRANSFER".
+	Type string `json:"type"`
+
+	// AssetID is the ID of the compressed NFT.
+	AssetID string `json:"assetId"`
+
+	// TreeID is the address of the Merkle tree holding the leaf.
+	TreeID string `json:"treeId"`
+
+	// LeafIndex is the leaf's index in the tree.
+	LeafIndex int64 `json:"leafIndex"`
+
+	// NewLeafOwner is the leaf's owner after the event.
+	NewLeafOwner string `json:"newLeafOwner"`
+
+	// OldLeafOwner is the leaf's owner before the event, empty for mints.
+	OldLeafOwner string `json:"oldLeafOwner"`
+
+	// Seq is the tree sequence number after the event.
+	Seq int64 `json:"seq"`
+}
+
+// CompressedEvents decodes the compressed NFT events in Events. It returns
+// nil if the event has none.
+//
+// Example:
+//
+//	cnftEvents, err := event.CompressedEvents()
+//	for _, ce := range cnftEvents {
+//	    owners[ce.AssetID] = ce.NewLeafOwner
+//	}
+func (e *WebhookEvent) CompressedEvents() ([]CompressedNFTEvent, error) {
+	if e.Events == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(e.Events)
+	if err != nil {
+		return nil, fmt.Errorf("marshal events: %w", err)
+	}
+
+	var events struct {
+		Compressed []CompressedNFTEvent `json:"compressed"`
+	}
+	if err := json.Unmarshal(data, &events); err != nil {
+		return nil, fmt.Errorf("decode compressed events: %w", err)
+	}
+	return events.Compressed, nil
+}
+
 // ParseWebhookEvent parses a webhook payload into a WebhookEvent.
 func ParseWebhookEvent(body []byte) (*WebhookEvent, error) {
 	var event WebhookEvent
 	if err := json.Unmarshal(body, &event); err != nil {
 		return nil, fmt.Errorf("parse webhook event: %w", err)
 	}
+	event.raw = append(json.RawMessage(nil), body...)
 	return &event, nil
 }
 
@@ -395,7 +892,415 @@ func ParseWebhookEvents(body []byte) ([]WebhookEvent, error) {
 		if err := json.Unmarshal(body, &event); err != nil {
 			return nil, fmt.Errorf("parse webhook events: %w", err)
 		}
+		event.raw = append(json.RawMessage(nil), body...)
 		return []WebhookEvent{event}, nil
 	}
 	return events, nil
 }
+
+// ParseWebhookEventsLenient is like ParseWebhookEvents, but decodes an array
+// payload one element at a time, so that a malformed event does not cause
+// the others to be dropped. It returns the events that decoded, in order,
+// and an error for each element that did not, naming its index in the
+// payload.
+//
+// If the payload is not a JSON array or object at all, no events are
+// returned, along with a single error.
+//
+// Example:
+//
+//	events, errs := helius.ParseWebhookEventsLenient(body)
+//	for _, err := range errs {
+//	    log.Printf("skipping webhook event: %v", err)
+//	}
+func ParseWebhookEventsLenient(body []byte) ([]WebhookEvent, []error) {
+	var elems []json.RawMessage
+	if err := json.Unmarshal(body, &elems); err != nil {
+		event, err := ParseWebhookEvent(body)
+		if err != nil {
+			return nil, []error{err}
+		}
+		return []WebhookEvent{*event}, nil
+	}
+
+	events := make([]WebhookEvent, 0, len(elems))
+	var errs []error
+	for i, elem := range elems {
+		if string(elem) == "null" {
+			errs = append(errs, fmt.Errorf("parse webhook event %d: event is null", i))
+			continue
+		}
+		var event WebhookEvent
+		if err := json.Unmarshal(elem, &event); err != nil {
+			errs = append(errs, fmt.Errorf("parse webhook event %d: %w", i, err))
+			continue
+		}
+		events = append(events, event)
+	}
+	return events, errs
+}
+
+// NativeBalanceChange returns the net change in lamports for account in this
+// transaction.
+//
+// AccountData is the source of truth: if the account appears there, the sum
+// of its NativeBalanceChange entries is returned and NativeTransfers are
+// ignored, since accountData already reflects those transfers (plus fees).
+// Only when the account is absent from AccountData is the result computed by
+// netting NativeTransfers where the account is the sender or receiver.
+func (e *WebhookEvent) NativeBalanceChange(account string) int64 {
+	var change int64
+	var found bool
+	for _, ad := range e.AccountData {
+		if ad.Account == account {
+			change += ad.NativeBalanceChange
+			found = true
+		}
+	}
+	if found {
+		return change
+	}
+
+	for _, t := range e.NativeTransfers {
+		if t.FromUserAccount == account {
+			change -= t.Amount
+		}
+		if t.ToUserAccount == account {
+			change += t.Amount
+		}
+	}
+	return change
+}
+
+// TokenBalanceChange returns the net raw change and decimals of mint for
+// account in this transaction, computed from AccountData[].TokenBalanceChanges.
+//
+// account may be either the owning wallet (userAccount) or the token account
+// itself. The returned amount is in raw units; divide by 10^decimals for the
+// UI amount. When no matching entry exists a zero value and zero decimals are
+// returned.
+func (e *WebhookEvent) TokenBalanceChange(account, mint string) (*big.Int, int, error) {
+	total := new(big.Int)
+	var decimals int
+
+	for _, ad := range e.AccountData {
+		for _, tbc := range ad.TokenBalanceChanges {
+			if tbc.Mint != mint {
+				continue
+			}
+			if ad.Account != account && tbc.UserAccount != account && tbc.TokenAccount != account {
+				continue
+			}
+
+			amount, ok := new(big.Int).SetString(tbc.RawTokenAmount.TokenAmount, 10)
+			if !ok {
+				return nil, 0, fmt.Errorf("parse token amount %q: invalid integer", tbc.RawTokenAmount.TokenAmount)
+			}
+			total.Add(total, amount)
+			decimals = tbc.RawTokenAmount.Decimals
+		}
+	}
+
+	return total, decimals, nil
+}
+
+// NetFlows summarizes the transaction's transfers from the fee payer's point
+// of view. It is shorthand for NetFlowsFor(e.FeePayer).
+func (e *WebhookEvent) NetFlows() (nativeIn, nativeOut int64, tokenFlows map[string]float64) {
+	return e.NetFlowsFor(e.FeePayer)
+}
+
+// NetFlowsFor summarizes NativeTransfers and TokenTransfers relative to
+// account. nativeIn and nativeOut are the lamports account received and sent;
+// tokenFlows maps each mint account touched to its net UI amount, positive
+// for a net inflow and negative for a net outflow. Transfers from account to
+// itself are ignored.
+//
+// Unlike NativeBalanceChange, fees and other balance changes not expressed as
+// transfers are not included.
+func (e *WebhookEvent) NetFlowsFor(account string) (nativeIn, nativeOut int64, tokenFlows map[string]float64) {
+	for _, t := range e.NativeTransfers {
+		if t.FromUserAccount == t.ToUserAccount {
+			continue
+		}
+		if t.ToUserAccount == account {
+			nativeIn += t.Amount
+		}
+		if t.FromUserAccount == account {
+			nativeOut += t.Amount
+		}
+	}
+
+	tokenFlows = make(map[string]float64)
+	for _, t := range e.TokenTransfers {
+		if t.FromUserAccount == t.ToUserAccount {
+			continue
+		}
+		if t.ToUserAccount == account {
+			tokenFlows[t.Mint] += t.TokenAmount
+		}
+		if t.FromUserAccount == account {
+			tokenFlows[t.Mint] -= t.TokenAmount
+		}
+	}
+	return nativeIn, nativeOut, tokenFlows
+}
+
+// systemProgramID is the address of the Solana System Program, which shows up
+// as the other side of rent and account-creation transfers.
+const systemProgramID = "11111111111111111111111111111111"
+
+// Counterparties returns the accounts that sent native SOL or tokens to
+// account, or received them from it, in the order they first appear in
+// NativeTransfers and then TokenTransfers, without duplicates.
+//
+// Only transfers with account on one side are considered, so a relayer that
+// only paid the fee, or intermediate hops of a routed swap that never touch
+// account, are not counterparties. account itself, the System Program and
+// empty addresses, as on mints and burns, are excluded.
+func (e *WebhookEvent) Counterparties(account string) []string {
+	var parties []string
+	seen := map[string]bool{account: true, systemProgramID: true, "": true}
+	add := func(from, to string) {
+		var other string
+		switch account {
+		case from:
+			other = to
+		case to:
+			other = from
+		default:
+			return
+		}
+		if !seen[other] {
+			seen[other] = true
+			parties = append(parties, other)
+		}
+	}
+
+	for _, t := range e.NativeTransfers {
+		add(t.FromUserAccount, t.ToUserAccount)
+	}
+	for _, t := range e.TokenTransfers {
+		add(t.FromUserAccount, t.ToUserAccount)
+	}
+	return parties
+}
+
+// dexNames maps the Source values Helius reports for DEXes and aggregators
+// to their canonical names.
+var dexNames = map[string]string{
+	"JUPITER":   "Jupiter",
+	"RAYDIUM":   "Raydium",
+	"ORCA":      "Orca",
+	"METEORA":   "Meteora",
+	"PHOENIX":   "Phoenix",
+	"OPENBOOK":  "OpenBook",
+	"SERUM":     "Serum",
+	"LIFINITY":  "Lifinity",
+	"SABER":     "Saber",
+	"ALDRIN":    "Aldrin",
+	"CREMA":     "Crema",
+	"CROPPER":   "Cropper",
+	"INVARIANT": "Invariant",
+	"MERCURIAL": "Mercurial",
+	"PUMP_FUN":  "Pump.fun",
+}
+
+// DEX returns the canonical name of the DEX or aggregator in Source, e.g.
+// "Jupiter" for "JUPITER", for classifying swaps by venue. Matching ignores
+// case. A source that is not a known DEX is returned unchanged, so new
+// venues still group consistently; the raw value is always in Source.
+func (e *WebhookEvent) DEX() string {
+	if name, ok := dexNames[strings.ToUpper(e.Source)]; ok {
+		return name
+	}
+	return e.Source
+}
+
+// IsSwap reports whether the event is a swap, i.e. its Type is
+// TransactionTypeSwap.
+func (e *WebhookEvent) IsSwap() bool {
+	return e.Type == string(TransactionTypeSwap)
+}
+
+// FilterWebhookEvents returns the events whose Type matches any of types.
+//
+// This is useful when a webhook monitors TransactionTypeAny but a handler
+// only cares about a subset. If no types are given, events is returned
+// unchanged.
+//
+// Example:
+//
+//	events, _ := helius.ParseWebhookEvents(body)
+//	swaps := helius.FilterWebhookEvents(events, helius.TransactionTypeSwap)
+func FilterWebhookEvents(events []WebhookEvent, types ...TransactionType) []WebhookEvent {
+	if len(types) == 0 {
+		return events
+	}
+
+	filtered := make([]WebhookEvent, 0, len(events))
+	for _, event := range events {
+		for _, t := range types {
+			if event.Type == string(t) {
+				filtered = append(filtered, event)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// WebhookSummary aggregates a batch of webhook events, e.g. for logging
+// per-delivery stats.
+type WebhookSummary struct {
+	// Count is the number of events.
+	Count int
+
+	// ByType counts events by Type. Events without a type (raw webhooks) are
+	// counted under the empty string.
+	ByType map[string]int
+
+	// BySource counts events by Source, with the same treatment of events
+	// without one.
+	BySource map[string]int
+
+	// TotalFeeLamports is the sum of the events' transaction fees.
+	TotalFeeLamports int64
+}
+
+// SummarizeWebhookEvents counts events by type and source and sums their
+// fees.
+//
+// Example:
+//
+//	summary := helius.SummarizeWebhookEvents(events)
+//	log.Printf("%d events, fees %d lamports, by type %v", summary.Count, summary.TotalFeeLamports, summary.ByType)
+func SummarizeWebhookEvents(events []WebhookEvent) WebhookSummary {
+	summary := WebhookSummary{
+		Count:    len(events),
+		ByType:   make(map[string]int),
+		BySource: make(map[string]int),
+	}
+	for i := range events {
+		summary.ByType[events[i].Type]++
+		summary.BySource[events[i].Source]++
+		summary.TotalFeeLamports += events[i].Fee
+	}
+	return summary
+}
+
+// WebhookHandler returns an http.Handler that validates and parses incoming
+// webhook deliveries before passing them to handler.
+//
+// The request body is limited to 10 MiB and its signature is checked against
+// the X-Helius-Signature header using ValidateWebhookSignature. Responses are:
+//   - 401 if the signature is missing or invalid
+//   - 400 if the body cannot be read or parsed
+//   - 413 if the body exceeds the size limit
+//   - 500 if handler returns an error
+//   - 200 otherwise
+//
+// Example:
+//
+//	http.Handle("/webhook", helius.WebhookHandler(secret, func(ctx context.Context, events []helius.WebhookEvent) error {
+//	    for _, event := range events {
+//	        log.Printf("tx %s (%s)", event.Signature, event.Type)
+//	    }
+//	    return nil
+//	}))
+func WebhookHandler(secret string, handler func(ctx context.Context, events []WebhookEvent) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBodySize))
+		if err != nil {
+			var maxErr *http.MaxBytesError
+			if errors.As(err, &maxErr) {
+				http.Error(w, "payload too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, "read body", http.StatusBadRequest)
+			return
+		}
+
+		if !ValidateWebhookSignature(body, r.Header.Get(WebhookSignatureHeader), secret) {
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+
+		events, err := ParseWebhookEvents(body)
+		if err != nil {
+			http.Error(w, "invalid payload", http.StatusBadRequest)
+			return
+		}
+
+		if err := handler(r.Context(), events); err != nil {
+			http.Error(w, "handler error", http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// WebhookDeduper detects re-delivered webhook events by remembering the most
+// recently seen transaction signatures.
+//
+// Helius may deliver the same event more than once when retrying; handlers
+// can call Seen to skip events that were already processed. Memory is bounded
+// by evicting the least recently seen signature once capacity is reached. A
+// WebhookDeduper is safe for concurrent use.
+//
+// Example:
+//
+//	dedup := helius.NewWebhookDeduper(0)
+//	for _, event := range events {
+//	    if dedup.Seen(event.Signature) {
+//	        continue
+//	    }
+//	    process(event)
+//	}
+type WebhookDeduper struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List // front is most recently seen
+	entries  map[string]*list.Element
+}
+
+// NewWebhookDeduper creates a WebhookDeduper that remembers up to capacity
+// signatures. If capacity is not positive, DefaultDeduperCapacity is used.
+func NewWebhookDeduper(capacity int) *WebhookDeduper {
+	if capacity <= 0 {
+		capacity = DefaultDeduperCapacity
+	}
+	return &WebhookDeduper{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element, capacity),
+	}
+}
+
+// Seen reports whether signature was seen before and records it as the most
+// recently seen signature.
+func (d *WebhookDeduper) Seen(signature string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if elem, ok := d.entries[signature]; ok {
+		d.order.MoveToFront(elem)
+		return true
+	}
+
+	d.entries[signature] = d.order.PushFront(signature)
+	if d.order.Len() > d.capacity {
+		oldest := d.order.Back()
+		d.order.Remove(oldest)
+		delete(d.entries, oldest.Value.(string))
+	}
+	return false
+}
+
+// Len returns the number of signatures currently remembered.
+func (d *WebhookDeduper) Len() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.order.Len()
+}
@@ -1,13 +1,20 @@
 package helius
 
 import (
+	"bytes"
 	"context"
 	"crypto/hmac"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
 )
 
@@ -62,6 +69,30 @@ func TestValidateWebhookSignature(t *testing.T) {
 		}
 	})
 
+	t.Run("sha256 prefix", func(t *testing.T) {
+		if !ValidateWebhookSignature(body, "sha256="+validSignature, secret) {
+			t.Error("ValidateWebhookSignature should accept sha256= prefixed signature")
+		}
+	})
+
+	t.Run("uppercase sha256 prefix", func(t *testing.T) {
+		if !ValidateWebhookSignature(body, "SHA256="+validSignature, secret) {
+			t.Error("ValidateWebhookSignature should accept SHA256= prefixed signature")
+		}
+	})
+
+	t.Run("sha256 prefix with wrong signature", func(t *testing.T) {
+		if ValidateWebhookSignature(body, "sha256=wrong-signature", secret) {
+			t.Error("ValidateWebhookSignature should return false for prefixed invalid signature")
+		}
+	})
+
+	t.Run("prefix only", func(t *testing.T) {
+		if ValidateWebhookSignature(body, "sha256=", secret) {
+			t.Error("ValidateWebhookSignature should return false for bare prefix")
+		}
+	})
+
 	t.Run("timing attack resistance", func(t *testing.T) {
 		// This test verifies we use constant-time comparison
 		// by checking that very similar signatures still fail
@@ -167,6 +198,9 @@ func TestCreateWebhook(t *testing.T) {
 		if err == nil {
 			t.Error("CreateWebhook should return error for nil request")
 		}
+		if _, ok := IsValidationError(err); !ok {
+			t.Errorf("error should be ValidationError, got %T", err)
+		}
 	})
 
 	t.Run("empty webhook url", func(t *testing.T) {
@@ -178,6 +212,9 @@ func TestCreateWebhook(t *testing.T) {
 		if err == nil {
 			t.Error("CreateWebhook should return error for empty webhookURL")
 		}
+		if _, ok := IsValidationError(err); !ok {
+			t.Errorf("error should be ValidationError, got %T", err)
+		}
 	})
 
 	t.Run("empty transaction types", func(t *testing.T) {
@@ -189,6 +226,9 @@ func TestCreateWebhook(t *testing.T) {
 		if err == nil {
 			t.Error("CreateWebhook should return error for empty transactionTypes")
 		}
+		if _, ok := IsValidationError(err); !ok {
+			t.Errorf("error should be ValidationError, got %T", err)
+		}
 	})
 
 	t.Run("empty account addresses", func(t *testing.T) {
@@ -200,6 +240,9 @@ func TestCreateWebhook(t *testing.T) {
 		if err == nil {
 			t.Error("CreateWebhook should return error for empty accountAddresses")
 		}
+		if _, ok := IsValidationError(err); !ok {
+			t.Errorf("error should be ValidationError, got %T", err)
+		}
 	})
 }
 
@@ -238,6 +281,9 @@ func TestGetWebhook(t *testing.T) {
 		if err == nil {
 			t.Error("GetWebhook should return error for empty webhookID")
 		}
+		if _, ok := IsValidationError(err); !ok {
+			t.Errorf("error should be ValidationError, got %T", err)
+		}
 	})
 }
 
@@ -306,6 +352,9 @@ func TestUpdateWebhook(t *testing.T) {
 		if err == nil {
 			t.Error("UpdateWebhook should return error for empty webhookID")
 		}
+		if _, ok := IsValidationError(err); !ok {
+			t.Errorf("error should be ValidationError, got %T", err)
+		}
 	})
 
 	t.Run("nil request", func(t *testing.T) {
@@ -314,6 +363,9 @@ func TestUpdateWebhook(t *testing.T) {
 		if err == nil {
 			t.Error("UpdateWebhook should return error for nil request")
 		}
+		if _, ok := IsValidationError(err); !ok {
+			t.Errorf("error should be ValidationError, got %T", err)
+		}
 	})
 }
 
@@ -344,9 +396,429 @@ func TestDeleteWebhook(t *testing.T) {
 		if err == nil {
 			t.Error("DeleteWebhook should return error for empty webhookID")
 		}
+		if _, ok := IsValidationError(err); !ok {
+			t.Errorf("error should be ValidationError, got %T", err)
+		}
+	})
+}
+
+func TestCreateWebhooksSharded(t *testing.T) {
+	addresses := make([]string, 25000)
+	for i := range addresses {
+		addresses[i] = fmt.Sprintf("address-%d", i)
+	}
+
+	t.Run("creates one webhook per chunk", func(t *testing.T) {
+		var sizes []int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req CreateWebhookRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.WebhookURL != "https://example.com/hook" || len(req.TransactionTypes) != 1 {
+				t.Errorf("request = %s %v, want shared URL and types", req.WebhookURL, req.TransactionTypes)
+			}
+			sizes = append(sizes, len(req.AccountAddresses))
+			json.NewEncoder(w).Encode(Webhook{
+				WebhookID:        fmt.Sprintf("webhook-%d", len(sizes)),
+				WebhookURL:       req.WebhookURL,
+				AccountAddresses: req.AccountAddresses,
+			})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		webhooks, err := client.CreateWebhooksSharded(context.Background(), &CreateWebhookRequest{
+			WebhookURL:       "https://example.com/hook",
+			TransactionTypes: []TransactionType{TransactionTypeAny},
+			AccountAddresses: addresses,
+		})
+		if err != nil {
+			t.Fatalf("CreateWebhooksSharded returned error: %v", err)
+		}
+
+		if !reflect.DeepEqual(sizes, []int{10000, 10000, 5000}) {
+			t.Errorf("chunk sizes = %v, want [10000 10000 5000]", sizes)
+		}
+		if len(webhooks) != 3 {
+			t.Fatalf("len(webhooks) = %d, want 3", len(webhooks))
+		}
+		if webhooks[2].WebhookID != "webhook-3" || webhooks[2].AccountAddresses[0] != "address-20000" {
+			t.Errorf("webhooks[2] = %s starting at %s, want webhook-3 starting at address-20000",
+				webhooks[2].WebhookID, webhooks[2].AccountAddresses[0])
+		}
+	})
+
+	t.Run("rolls back on failure", func(t *testing.T) {
+		var creates int
+		var deleted []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPost:
+				creates++
+				if creates == 3 {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write([]byte(`{"error":"webhook limit reached"}`))
+					return
+				}
+				json.NewEncoder(w).Encode(Webhook{WebhookID: fmt.Sprintf("webhook-%d", creates)})
+			case http.MethodDelete:
+				deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/webhooks/"))
+			}
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		webhooks, err := client.CreateWebhooksSharded(context.Background(), &CreateWebhookRequest{
+			WebhookURL:       "https://example.com/hook",
+			TransactionTypes: []TransactionType{TransactionTypeAny},
+			AccountAddresses: addresses,
+		})
+		if err == nil {
+			t.Fatal("expected error for failed create")
+		}
+		if webhooks != nil {
+			t.Errorf("webhooks = %v, want nil", webhooks)
+		}
+		if apiErr, ok := IsAPIError(err); !ok || apiErr.StatusCode != http.StatusBadRequest {
+			t.Errorf("err = %v, want it to wrap the APIError", err)
+		}
+		if !reflect.DeepEqual(deleted, []string{"webhook-1", "webhook-2"}) {
+			t.Errorf("deleted = %v, want [webhook-1 webhook-2]", deleted)
+		}
+	})
+
+	t.Run("no addresses", func(t *testing.T) {
+		client, _ := NewClient("test-key")
+		_, err := client.CreateWebhooksSharded(context.Background(), &CreateWebhookRequest{WebhookURL: "https://example.com/hook"})
+		if valErr, ok := IsValidationError(err); !ok || valErr.Field != "accountAddresses" {
+			t.Errorf("err = %v, want ValidationError for accountAddresses", err)
+		}
+	})
+}
+
+func TestEnsureWebhook(t *testing.T) {
+	existing := []Webhook{
+		{
+			WebhookID:        "webhook-1",
+			WebhookURL:       "https://example.com/hook",
+			TransactionTypes: []TransactionType{TransactionTypeSwap, TransactionTypeTransfer},
+			AccountAddresses: []string{"addr-1", "addr-2"},
+			WebhookType:      WebhookTypeEnhanced,
+		},
+	}
+
+	newServer := func(calls *[]string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*calls = append(*calls, r.Method+" "+r.URL.Path)
+			switch r.Method {
+			case http.MethodGet:
+				json.NewEncoder(w).Encode(existing)
+			case http.MethodPost, http.MethodPut:
+				var webhook Webhook
+				json.NewDecoder(r.Body).Decode(&webhook)
+				webhook.WebhookID = strings.TrimPrefix(r.URL.Path, "/webhooks/")
+				if r.Method == http.MethodPost {
+					webhook.WebhookID = "webhook-new"
+				}
+				json.NewEncoder(w).Encode(webhook)
+			}
+		}))
+	}
+
+	t.Run("creates when no webhook matches", func(t *testing.T) {
+		var calls []string
+		server := newServer(&calls)
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		webhook, err := client.EnsureWebhook(context.Background(), &CreateWebhookRequest{
+			WebhookURL:       "https://example.com/other",
+			TransactionTypes: []TransactionType{TransactionTypeAny},
+			AccountAddresses: []string{"addr-1"},
+		})
+		if err != nil {
+			t.Fatalf("EnsureWebhook returned error: %v", err)
+		}
+		if webhook.WebhookID != "webhook-new" {
+			t.Errorf("WebhookID = %s, want webhook-new", webhook.WebhookID)
+		}
+		if want := []string{"GET /webhooks", "POST /webhooks"}; !reflect.DeepEqual(calls, want) {
+			t.Errorf("calls = %v, want %v", calls, want)
+		}
+	})
+
+	t.Run("updates a matching webhook", func(t *testing.T) {
+		var calls []string
+		server := newServer(&calls)
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		webhook, err := client.EnsureWebhook(context.Background(), &CreateWebhookRequest{
+			WebhookURL:       "https://example.com/hook",
+			TransactionTypes: []TransactionType{TransactionTypeSwap},
+			AccountAddresses: []string{"addr-1", "addr-3"},
+		})
+		if err != nil {
+			t.Fatalf("EnsureWebhook returned error: %v", err)
+		}
+		if webhook.WebhookID != "webhook-1" {
+			t.Errorf("WebhookID = %s, want webhook-1", webhook.WebhookID)
+		}
+		if !reflect.DeepEqual(webhook.AccountAddresses, []string{"addr-1", "addr-3"}) {
+			t.Errorf("AccountAddresses = %v, want [addr-1 addr-3]", webhook.AccountAddresses)
+		}
+		if want := []string{"GET /webhooks", "PUT /webhooks/webhook-1"}; !reflect.DeepEqual(calls, want) {
+			t.Errorf("calls = %v, want %v", calls, want)
+		}
+	})
+
+	t.Run("leaves an up-to-date webhook alone", func(t *testing.T) {
+		var calls []string
+		server := newServer(&calls)
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		webhook, err := client.EnsureWebhook(context.Background(), &CreateWebhookRequest{
+			WebhookURL:       "https://example.com/hook",
+			TransactionTypes: []TransactionType{TransactionTypeTransfer, TransactionTypeSwap},
+			AccountAddresses: []string{"addr-2", "addr-1"},
+		})
+		if err != nil {
+			t.Fatalf("EnsureWebhook returned error: %v", err)
+		}
+		if webhook.WebhookID != "webhook-1" {
+			t.Errorf("WebhookID = %s, want webhook-1", webhook.WebhookID)
+		}
+		if want := []string{"GET /webhooks"}; !reflect.DeepEqual(calls, want) {
+			t.Errorf("calls = %v, want %v", calls, want)
+		}
+	})
+
+	t.Run("second run makes no update", func(t *testing.T) {
+		// The server keeps the webhook and, like the API, only updates the
+		// fields present in a PUT.
+		stored := Webhook{
+			WebhookID:        "webhook-1",
+			WebhookURL:       "https://example.com/hook",
+			TransactionTypes: []TransactionType{TransactionTypeSwap},
+			AccountAddresses: []string{"addr-1"},
+			WebhookType:      WebhookTypeEnhanced,
+			AuthHeader:       "Bearer secret",
+		}
+		var calls []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls = append(calls, r.Method+" "+r.URL.Path)
+			if r.Method == http.MethodPut {
+				var update Webhook
+				json.NewDecoder(r.Body).Decode(&update)
+				if len(update.AccountAddresses) > 0 {
+					stored.AccountAddresses = update.AccountAddresses
+				}
+				if len(update.TransactionTypes) > 0 {
+					stored.TransactionTypes = update.TransactionTypes
+				}
+				if update.AuthHeader != "" {
+					stored.AuthHeader = update.AuthHeader
+				}
+				json.NewEncoder(w).Encode(stored)
+				return
+			}
+			json.NewEncoder(w).Encode([]Webhook{stored})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("test-key", WithAPIURL(server.URL))
+		req := &CreateWebhookRequest{
+			WebhookURL:       "https://example.com/hook",
+			AccountAddresses: []string{"addr-1", "addr-2"},
+		}
+		for i := 0; i < 2; i++ {
+			if _, err := client.EnsureWebhook(context.Background(), req); err != nil {
+				t.Fatalf("EnsureWebhook run %d returned error: %v", i+1, err)
+			}
+		}
+		want := []string{"GET /webhooks", "PUT /webhooks/webhook-1", "GET /webhooks"}
+		if !reflect.DeepEqual(calls, want) {
+			t.Errorf("calls = %v, want %v", calls, want)
+		}
+		if stored.AuthHeader != "Bearer secret" {
+			t.Errorf("AuthHeader = %q, want it kept", stored.AuthHeader)
+		}
+	})
+
+	t.Run("logs an update only when there are changes", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode([]Webhook{{
+				WebhookID:        "webhook-1",
+				WebhookURL:       "https://example.com/hook",
+				TransactionTypes: []TransactionType{TransactionTypeSwap},
+				AccountAddresses: []string{"addr-1"},
+				WebhookType:      WebhookTypeEnhanced,
+				AuthHeader:       "Bearer secret",
+			}})
+		}))
+		defer server.Close()
+
+		logger := &recordingLogger{}
+		client, _ := NewClient("test-key", WithAPIURL(server.URL), WithLogger(logger))
+		_, err := client.EnsureWebhook(context.Background(), &CreateWebhookRequest{
+			WebhookURL:       "https://example.com/hook",
+			AccountAddresses: []string{"addr-1"},
+		})
+		if err != nil {
+			t.Fatalf("EnsureWebhook returned error: %v", err)
+		}
+		for _, entry := range logger.entries {
+			if strings.HasPrefix(entry, "updating webhook") {
+				t.Errorf("logged %q for an up-to-date webhook", entry)
+			}
+		}
+	})
+
+	t.Run("missing url", func(t *testing.T) {
+		client, _ := NewClient("test-key")
+		_, err := client.EnsureWebhook(context.Background(), &CreateWebhookRequest{})
+		if valErr, ok := IsValidationError(err); !ok || valErr.Field != "webhookURL" {
+			t.Errorf("err = %v, want ValidationError for webhookURL", err)
+		}
+	})
+}
+
+func TestWebhook_Diff(t *testing.T) {
+	base := &Webhook{
+		WebhookID:        "webhook-123",
+		WebhookURL:       "https://example.com/webhook",
+		TransactionTypes: []TransactionType{TransactionTypeSwap, TransactionTypeNFTSale},
+		AccountAddresses: []string{"addr-1", "addr-2", "addr-3"},
+		WebhookType:      WebhookTypeEnhanced,
+		AuthHeader:       "Bearer token",
+	}
+
+	tests := []struct {
+		name  string
+		other *Webhook
+		want  WebhookDiff
+	}{
+		{
+			name: "identical ignoring order and id",
+			other: &Webhook{
+				WebhookID:        "other-id",
+				WebhookURL:       "https://example.com/webhook",
+				TransactionTypes: []TransactionType{TransactionTypeNFTSale, TransactionTypeSwap},
+				AccountAddresses: []string{"addr-3", "addr-1", "addr-2", "addr-1"},
+				WebhookType:      WebhookTypeEnhanced,
+				AuthHeader:       "Bearer token",
+			},
+			want: WebhookDiff{},
+		},
+		{
+			name: "addresses changed",
+			other: &Webhook{
+				WebhookURL:       "https://example.com/webhook",
+				TransactionTypes: []TransactionType{TransactionTypeSwap, TransactionTypeNFTSale},
+				AccountAddresses: []string{"addr-2", "addr-4", "addr-5"},
+				WebhookType:      WebhookTypeEnhanced,
+				AuthHeader:       "Bearer token",
+			},
+			want: WebhookDiff{
+				AddedAddresses:   []string{"addr-4", "addr-5"},
+				RemovedAddresses: []string{"addr-1", "addr-3"},
+			},
+		},
+		{
+			name: "types changed",
+			other: &Webhook{
+				WebhookURL:       "https://example.com/webhook",
+				TransactionTypes: []TransactionType{TransactionTypeSwap, TransactionTypeTransfer},
+				AccountAddresses: []string{"addr-1", "addr-2", "addr-3"},
+				WebhookType:      WebhookTypeRaw,
+				AuthHeader:       "Bearer token",
+			},
+			want: WebhookDiff{
+				AddedTransactionTypes:   []TransactionType{TransactionTypeTransfer},
+				RemovedTransactionTypes: []TransactionType{TransactionTypeNFTSale},
+				WebhookTypeChanged:      true,
+			},
+		},
+		{
+			name: "url and auth header changed",
+			other: &Webhook{
+				WebhookURL:       "https://example.com/other",
+				TransactionTypes: []TransactionType{TransactionTypeSwap, TransactionTypeNFTSale},
+				AccountAddresses: []string{"addr-1", "addr-2", "addr-3"},
+				WebhookType:      WebhookTypeEnhanced,
+			},
+			want: WebhookDiff{
+				URLChanged:        true,
+				AuthHeaderChanged: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := base.Diff(tt.other)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Diff() = %+v, want %+v", got, tt.want)
+			}
+			if got.HasChanges() != !reflect.DeepEqual(tt.want, WebhookDiff{}) {
+				t.Errorf("HasChanges() = %v", got.HasChanges())
+			}
+		})
+	}
+
+	t.Run("nil webhook", func(t *testing.T) {
+		var w *Webhook
+		d := w.Diff(base)
+		if !d.HasChanges() || len(d.AddedAddresses) != 3 || len(d.RemovedAddresses) != 0 {
+			t.Errorf("Diff() = %+v, want all of base added", d)
+		}
 	})
 }
 
+func TestDeleteAllWebhooks(t *testing.T) {
+	var mu sync.Mutex
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/webhooks":
+			json.NewEncoder(w).Encode([]Webhook{
+				{WebhookID: "webhook-1"},
+				{WebhookID: "webhook-2"},
+				{WebhookID: "webhook-3"},
+			})
+		case r.Method == http.MethodDelete && r.URL.Path == "/webhooks/webhook-2":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("webhook not found"))
+		case r.Method == http.MethodDelete:
+			mu.Lock()
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/webhooks/"))
+			mu.Unlock()
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-key", WithAPIURL(server.URL))
+	count, err := client.DeleteAllWebhooks(context.Background())
+
+	if count != 2 {
+		t.Errorf("count = %d, want 2", count)
+	}
+	if err == nil {
+		t.Fatal("expected error for failed delete")
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("err = %v, want it to wrap ErrNotFound", err)
+	}
+	if !strings.Contains(err.Error(), "webhook-2") {
+		t.Errorf("err = %v, want it to name webhook-2", err)
+	}
+	if !reflect.DeepEqual(deleted, []string{"webhook-1", "webhook-3"}) {
+		t.Errorf("deleted = %v, want [webhook-1 webhook-3]", deleted)
+	}
+}
+
 func TestParseWebhookEvent(t *testing.T) {
 	t.Run("valid event", func(t *testing.T) {
 		body := []byte(`{
@@ -442,6 +914,102 @@ func TestParseWebhookEvent(t *testing.T) {
 	})
 }
 
+func TestParseWebhookEvent_NumericFields(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"numbers", `{"signature":"tx1","fee":5000,"slot":250000000,"timestamp":1700000000}`},
+		{"strings", `{"signature":"tx1","fee":"5000","slot":"250000000","timestamp":"1700000000"}`},
+		{"mixed", `{"signature":"tx1","fee":"5000","slot":250000000,"timestamp":"1700000000"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := ParseWebhookEvent([]byte(tt.body))
+			if err != nil {
+				t.Fatalf("ParseWebhookEvent returned error: %v", err)
+			}
+			if event.Fee != 5000 || event.Slot != 250000000 || event.Timestamp != 1700000000 {
+				t.Errorf("Fee/Slot/Timestamp = %d/%d/%d, want 5000/250000000/1700000000", event.Fee, event.Slot, event.Timestamp)
+			}
+			if event.Signature != "tx1" {
+				t.Errorf("Signature = %s, want tx1", event.Signature)
+			}
+		})
+	}
+
+	t.Run("null and empty", func(t *testing.T) {
+		event, err := ParseWebhookEvent([]byte(`{"signature":"tx1","fee":null,"slot":""}`))
+		if err != nil {
+			t.Fatalf("ParseWebhookEvent returned error: %v", err)
+		}
+		if event.Fee != 0 || event.Slot != 0 {
+			t.Errorf("Fee/Slot = %d/%d, want 0/0", event.Fee, event.Slot)
+		}
+	})
+
+	t.Run("invalid number", func(t *testing.T) {
+		if _, err := ParseWebhookEvent([]byte(`{"signature":"tx1","fee":"lots"}`)); err == nil {
+			t.Error("expected error for non-numeric fee")
+		}
+	})
+}
+
+func TestParseWebhookEventsLenient(t *testing.T) {
+	t.Run("mixed validity", func(t *testing.T) {
+		body := []byte(`[
+			{"signature": "sig-1", "type": "SWAP", "slot": 100},
+			{"signature": "sig-2", "slot": "not-a-number"},
+			"not an event",
+			null,
+			{"signature": "sig-5", "type": "TRANSFER"}
+		]`)
+
+		events, errs := ParseWebhookEventsLenient(body)
+
+		if len(events) != 2 || events[0].Signature != "sig-1" || events[1].Signature != "sig-5" {
+			t.Fatalf("events = %+v, want sig-1 and sig-5", events)
+		}
+		if events[0].Slot != 100 {
+			t.Errorf("Slot = %d, want 100", events[0].Slot)
+		}
+		if string(events[1].Raw()) != `{"signature": "sig-5", "type": "TRANSFER"}` {
+			t.Errorf("Raw() = %s, want the element's bytes", events[1].Raw())
+		}
+
+		if len(errs) != 3 {
+			t.Fatalf("len(errs) = %d, want 3: %v", len(errs), errs)
+		}
+		for i, index := range []string{"event 1", "event 2", "event 3"} {
+			if !strings.Contains(errs[i].Error(), index) {
+				t.Errorf("errs[%d] = %v, want it to name %s", i, errs[i], index)
+			}
+		}
+	})
+
+	t.Run("all valid", func(t *testing.T) {
+		events, errs := ParseWebhookEventsLenient([]byte(`[{"signature": "sig-1"}, {"signature": "sig-2"}]`))
+		if len(events) != 2 || errs != nil {
+			t.Errorf("got %d events, errs %v, want 2 events, nil", len(events), errs)
+		}
+	})
+
+	t.Run("single object", func(t *testing.T) {
+		events, errs := ParseWebhookEventsLenient([]byte(`{"signature": "sig-1"}`))
+		if len(events) != 1 || events[0].Signature != "sig-1" || errs != nil {
+			t.Errorf("got %+v, errs %v, want sig-1", events, errs)
+		}
+	})
+
+	t.Run("invalid payload", func(t *testing.T) {
+		events, errs := ParseWebhookEventsLenient([]byte(`not json`))
+		if events != nil || len(errs) != 1 {
+			t.Errorf("got %+v, errs %v, want no events and one error", events, errs)
+		}
+	})
+}
+
 func TestParseWebhookEvents(t *testing.T) {
 	t.Run("array of events", func(t *testing.T) {
 		body := []byte(`[
@@ -487,3 +1055,863 @@ func TestParseWebhookEvents(t *testing.T) {
 		}
 	})
 }
+
+func TestWebhookEvent_Raw(t *testing.T) {
+	t.Run("single event", func(t *testing.T) {
+		body := []byte(`{"signature": "tx1", "slot": 100, "events": {"nft": {"amount": 1}}}`)
+
+		event, err := ParseWebhookEvent(body)
+		if err != nil {
+			t.Fatalf("ParseWebhookEvent returned error: %v", err)
+		}
+		if !bytes.Equal(event.Raw(), body) {
+			t.Errorf("Raw() = %s, want %s", event.Raw(), body)
+		}
+
+		events, err := ParseWebhookEvents(body)
+		if err != nil {
+			t.Fatalf("ParseWebhookEvents returned error: %v", err)
+		}
+		if !bytes.Equal(events[0].Raw(), body) {
+			t.Errorf("Raw() = %s, want %s", events[0].Raw(), body)
+		}
+	})
+
+	t.Run("array of events", func(t *testing.T) {
+		first := `{"signature": "tx1", "slot": 100}`
+		second := `{"signature": "tx2",  "instructions": [{"programId": "abc"}]}`
+		body := []byte("[\n\t" + first + ",\n\t" + second + "\n]")
+
+		events, err := ParseWebhookEvents(body)
+		if err != nil {
+			t.Fatalf("ParseWebhookEvents returned error: %v", err)
+		}
+		if len(events) != 2 {
+			t.Fatalf("len(events) = %d, want 2", len(events))
+		}
+		if got := string(events[0].Raw()); got != first {
+			t.Errorf("events[0].Raw() = %s, want %s", got, first)
+		}
+		if got := string(events[1].Raw()); got != second {
+			t.Errorf("events[1].Raw() = %s, want %s", got, second)
+		}
+	})
+
+	t.Run("not decoded from JSON", func(t *testing.T) {
+		event := &WebhookEvent{Signature: "tx1"}
+		if event.Raw() != nil {
+			t.Errorf("Raw() = %s, want nil", event.Raw())
+		}
+	})
+}
+
+func TestWebhookEvent_ParsedInstructions(t *testing.T) {
+	t.Run("with inner instructions", func(t *testing.T) {
+		body := []byte(`{
+			"signature": "tx1",
+			"type": "SWAP",
+			"instructions": [
+				{
+					"accounts": ["wallet", "pool"],
+					"data": "3Bxs4h24hBtQy9rw",
+					"programId": "JUP6LkbZbjS1jKKwapdHNy74zcZ3tLUZoi5QNyVTaV4",
+					"innerInstructions": [
+						{"accounts": ["wallet", "vault"], "data": "3Bxs4", "programId": "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"}
+					]
+				},
+				{"accounts": [], "data": "", "programId": "ComputeBudget111111111111111111111111111111", "stackHeight": 1}
+			]
+		}`)
+
+		event, err := ParseWebhookEvent(body)
+		if err != nil {
+			t.Fatalf("ParseWebhookEvent returned error: %v", err)
+		}
+
+		instructions, err := event.ParsedInstructions()
+		if err != nil {
+			t.Fatalf("ParsedInstructions returned error: %v", err)
+		}
+		if len(instructions) != 2 {
+			t.Fatalf("len(instructions) = %d, want 2", len(instructions))
+		}
+
+		swap := instructions[0]
+		if swap.ProgramID != "JUP6LkbZbjS1jKKwapdHNy74zcZ3tLUZoi5QNyVTaV4" || swap.Data != "3Bxs4h24hBtQy9rw" {
+			t.Errorf("instructions[0] = %+v, unexpected program or data", swap)
+		}
+		if !reflect.DeepEqual(swap.Accounts, []string{"wallet", "pool"}) {
+			t.Errorf("Accounts = %v, want [wallet pool]", swap.Accounts)
+		}
+		if len(swap.InnerInstructions) != 1 || swap.InnerInstructions[0].ProgramID != "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA" {
+			t.Errorf("InnerInstructions = %+v, want one token program instruction", swap.InnerInstructions)
+		}
+		if instructions[1].InnerInstructions != nil {
+			t.Errorf("instructions[1].InnerInstructions = %v, want nil", instructions[1].InnerInstructions)
+		}
+
+		raw, _ := event.Instructions[1].(map[string]interface{})
+		if raw["stackHeight"] != float64(1) {
+			t.Errorf("raw instruction = %v, want unmodeled fields preserved", raw)
+		}
+	})
+
+	t.Run("no instructions", func(t *testing.T) {
+		event := &WebhookEvent{Signature: "tx1"}
+		instructions, err := event.ParsedInstructions()
+		if err != nil || instructions != nil {
+			t.Errorf("ParsedInstructions() = %v, %v, want nil, nil", instructions, err)
+		}
+	})
+
+	t.Run("malformed instruction", func(t *testing.T) {
+		event := &WebhookEvent{Instructions: []interface{}{"not-an-object"}}
+		if _, err := event.ParsedInstructions(); err == nil {
+			t.Error("expected error for malformed instruction")
+		}
+	})
+}
+
+func TestWebhookEvent_CompressedEvents(t *testing.T) {
+	t.Run("compressed transfer", func(t *testing.T) {
+		body := []byte(`{
+			"signature": "tx1",
+			"type": "COMPRESSED_NFT_TRANSFER",
+			"source": "BUBBLEGUM",
+			"events": {
+				"compressed": [
+					{
+						"type": "COMPRESSED_NFT_TRANSFER",
+						"treeId": "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA",
+						"assetId": "JDuAmJgJ7fmUJhtsiTUvdqYzznuEygrkwQZGbsoTCs6w",
+						"leafIndex": 1041,
+						"instructionIndex": 0,
+						"innerInstructionIndex": null,
+						"newLeafOwner": "wallet-b",
+						"oldLeafOwner": "wallet-a",
+						"newLeafDelegate": "wallet-b",
+						"oldLeafDelegate": "wallet-a",
+						"treeDelegate": "tree-authority",
+						"seq": 1042
+					}
+				]
+			}
+		}`)
+
+		event, err := ParseWebhookEvent(body)
+		if err != nil {
+			t.Fatalf("ParseWebhookEvent returned error: %v", err)
+		}
+
+		events, err := event.CompressedEvents()
+		if err != nil {
+			t.Fatalf("CompressedEvents returned error: %v", err)
+		}
+		want := []CompressedNFTEvent{{
+			Type:         "COMPRESSED_NFT_TRANSFER",
+			AssetID:      "JDuAmJgJ7fmUJhtsiTUvdqYzznuEygrkwQZGbsoTCs6w",
+			TreeID:       "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA",
+			LeafIndex:    1041,
+			NewLeafOwner: "wallet-b",
+			OldLeafOwner: "wallet-a",
+			Seq:          1042,
+		}}
+		if !reflect.DeepEqual(events, want) {
+			t.Errorf("CompressedEvents() = %+v, want %+v", events, want)
+		}
+	})
+
+	t.Run("no compressed events", func(t *testing.T) {
+		for _, event := range []*WebhookEvent{
+			{Signature: "tx1"},
+			{Signature: "tx2", Events: map[string]interface{}{"swap": map[string]interface{}{}}},
+		} {
+			events, err := event.CompressedEvents()
+			if err != nil || events != nil {
+				t.Errorf("%s: CompressedEvents() = %v, %v, want nil, nil", event.Signature, events, err)
+			}
+		}
+	})
+
+	t.Run("malformed events", func(t *testing.T) {
+		event := &WebhookEvent{Events: map[string]interface{}{"compressed": "not-a-list"}}
+		if _, err := event.CompressedEvents(); err == nil {
+			t.Error("expected error for malformed compressed events")
+		}
+	})
+}
+
+func TestWebhookEvent_NativeBalanceChange(t *testing.T) {
+	t.Run("transfers only", func(t *testing.T) {
+		event := &WebhookEvent{
+			NativeTransfers: []NativeTransfer{
+				{Amount: 1000, FromUserAccount: "alice", ToUserAccount: "bob"},
+				{Amount: 300, FromUserAccount: "bob", ToUserAccount: "alice"},
+				{Amount: 50, FromUserAccount: "carol", ToUserAccount: "bob"},
+			},
+		}
+
+		if got := event.NativeBalanceChange("alice"); got != -700 {
+			t.Errorf("NativeBalanceChange(alice) = %d, want -700", got)
+		}
+		if got := event.NativeBalanceChange("bob"); got != 750 {
+			t.Errorf("NativeBalanceChange(bob) = %d, want 750", got)
+		}
+		if got := event.NativeBalanceChange("dave"); got != 0 {
+			t.Errorf("NativeBalanceChange(dave) = %d, want 0", got)
+		}
+	})
+
+	t.Run("account data only", func(t *testing.T) {
+		event := &WebhookEvent{
+			AccountData: []AccountData{
+				{Account: "alice", NativeBalanceChange: -5000},
+				{Account: "bob", NativeBalanceChange: 2500},
+				{Account: "bob", NativeBalanceChange: 500},
+			},
+		}
+
+		if got := event.NativeBalanceChange("alice"); got != -5000 {
+			t.Errorf("NativeBalanceChange(alice) = %d, want -5000", got)
+		}
+		if got := event.NativeBalanceChange("bob"); got != 3000 {
+			t.Errorf("NativeBalanceChange(bob) = %d, want 3000", got)
+		}
+	})
+
+	t.Run("account data and transfers", func(t *testing.T) {
+		// accountData already includes the transfer plus the fee, so the
+		// transfer must not be counted a second time.
+		event := &WebhookEvent{
+			AccountData: []AccountData{
+				{Account: "alice", NativeBalanceChange: -1005000},
+				{Account: "bob", NativeBalanceChange: 1000000},
+			},
+			NativeTransfers: []NativeTransfer{
+				{Amount: 1000000, FromUserAccount: "alice", ToUserAccount: "bob"},
+				{Amount: 20, FromUserAccount: "alice", ToUserAccount: "carol"},
+			},
+		}
+
+		if got := event.NativeBalanceChange("alice"); got != -1005000 {
+			t.Errorf("NativeBalanceChange(alice) = %d, want -1005000", got)
+		}
+		if got := event.NativeBalanceChange("bob"); got != 1000000 {
+			t.Errorf("NativeBalanceChange(bob) = %d, want 1000000", got)
+		}
+		// carol is missing from accountData, so transfers are used.
+		if got := event.NativeBalanceChange("carol"); got != 20 {
+			t.Errorf("NativeBalanceChange(carol) = %d, want 20", got)
+		}
+	})
+}
+
+func TestWebhookEvent_TokenBalanceChange(t *testing.T) {
+	event := &WebhookEvent{
+		AccountData: []AccountData{
+			{
+				Account: "alice-usdc",
+				TokenBalanceChanges: []TokenBalanceChange{
+					{
+						Mint:           "usdc-mint",
+						RawTokenAmount: RawTokenAmount{Decimals: 6, TokenAmount: "-2500000"},
+						TokenAccount:   "alice-usdc",
+						UserAccount:    "alice",
+					},
+				},
+			},
+			{
+				Account: "bob-usdc",
+				TokenBalanceChanges: []TokenBalanceChange{
+					{
+						Mint:           "usdc-mint",
+						RawTokenAmount: RawTokenAmount{Decimals: 6, TokenAmount: "2500000"},
+						TokenAccount:   "bob-usdc",
+						UserAccount:    "bob",
+					},
+				},
+			},
+			{
+				Account: "bob-usdc-2",
+				TokenBalanceChanges: []TokenBalanceChange{
+					{
+						Mint:           "usdc-mint",
+						RawTokenAmount: RawTokenAmount{Decimals: 6, TokenAmount: "18446744073709551616"},
+						TokenAccount:   "bob-usdc-2",
+						UserAccount:    "bob",
+					},
+					{
+						Mint:           "bonk-mint",
+						RawTokenAmount: RawTokenAmount{Decimals: 5, TokenAmount: "100"},
+						TokenAccount:   "bob-usdc-2",
+						UserAccount:    "bob",
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		account      string
+		mint         string
+		wantAmount   string
+		wantDecimals int
+	}{
+		{"negative change", "alice", "usdc-mint", "-2500000", 6},
+		{"positive change by token account", "bob-usdc", "usdc-mint", "2500000", 6},
+		{"multi-entry change", "bob", "usdc-mint", "18446744073712051616", 6},
+		{"other mint", "bob", "bonk-mint", "100", 5},
+		{"unknown account", "carol", "usdc-mint", "0", 0},
+		{"unknown mint", "alice", "bonk-mint", "0", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, decimals, err := event.TokenBalanceChange(tt.account, tt.mint)
+			if err != nil {
+				t.Fatalf("TokenBalanceChange returned error: %v", err)
+			}
+			if amount.String() != tt.wantAmount {
+				t.Errorf("amount = %s, want %s", amount, tt.wantAmount)
+			}
+			if decimals != tt.wantDecimals {
+				t.Errorf("decimals = %d, want %d", decimals, tt.wantDecimals)
+			}
+		})
+	}
+
+	t.Run("invalid amount", func(t *testing.T) {
+		bad := &WebhookEvent{
+			AccountData: []AccountData{
+				{
+					Account: "alice",
+					TokenBalanceChanges: []TokenBalanceChange{
+						{Mint: "usdc-mint", RawTokenAmount: RawTokenAmount{TokenAmount: "1.5"}, UserAccount: "alice"},
+					},
+				},
+			},
+		}
+		if _, _, err := bad.TokenBalanceChange("alice", "usdc-mint"); err == nil {
+			t.Error("TokenBalanceChange should return error for non-integer amount")
+		}
+	})
+}
+
+func TestWebhookEvent_NetFlows(t *testing.T) {
+	// A two-hop swap: alice sells SOL and USDC for BONK, routed through two
+	// pools, with a SOL fee to a referral account.
+	event := &WebhookEvent{
+		FeePayer: "alice",
+		NativeTransfers: []NativeTransfer{
+			{Amount: 1_000_000, FromUserAccount: "alice", ToUserAccount: "pool-1"},
+			{Amount: 5_000, FromUserAccount: "alice", ToUserAccount: "referral"},
+			{Amount: 20_000, FromUserAccount: "pool-1", ToUserAccount: "alice"},
+			{Amount: 7, FromUserAccount: "alice", ToUserAccount: "alice"},
+		},
+		TokenTransfers: []TokenTransfer{
+			{Mint: "USDC", TokenAmount: 10, FromUserAccount: "alice", ToUserAccount: "pool-1"},
+			{Mint: "USDT", TokenAmount: 9.5, FromUserAccount: "pool-1", ToUserAccount: "pool-2"},
+			{Mint: "BONK", TokenAmount: 1500, FromUserAccount: "pool-2", ToUserAccount: "alice"},
+			{Mint: "USDC", TokenAmount: 2.5, FromUserAccount: "pool-1", ToUserAccount: "alice"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		account     string
+		wantIn      int64
+		wantOut     int64
+		wantFlows   map[string]float64
+		useFeePayer bool
+	}{
+		{
+			name:        "fee payer",
+			useFeePayer: true,
+			wantIn:      20_000,
+			wantOut:     1_005_000,
+			wantFlows:   map[string]float64{"USDC": -7.5, "BONK": 1500},
+		},
+		{
+			name:      "intermediate pool",
+			account:   "pool-1",
+			wantIn:    1_000_000,
+			wantOut:   20_000,
+			wantFlows: map[string]float64{"USDC": 7.5, "USDT": -9.5},
+		},
+		{
+			name:      "uninvolved account",
+			account:   "bob",
+			wantFlows: map[string]float64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var in, out int64
+			var flows map[string]float64
+			if tt.useFeePayer {
+				in, out, flows = event.NetFlows()
+			} else {
+				in, out, flows = event.NetFlowsFor(tt.account)
+			}
+
+			if in != tt.wantIn || out != tt.wantOut {
+				t.Errorf("native in/out = %d/%d, want %d/%d", in, out, tt.wantIn, tt.wantOut)
+			}
+			if !reflect.DeepEqual(flows, tt.wantFlows) {
+				t.Errorf("tokenFlows = %v, want %v", flows, tt.wantFlows)
+			}
+		})
+	}
+}
+
+func TestWebhookEvent_DEX(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{"JUPITER", "Jupiter"},
+		{"RAYDIUM", "Raydium"},
+		{"ORCA", "Orca"},
+		{"PUMP_FUN", "Pump.fun"},
+		{"meteora", "Meteora"},
+		{"SOME_NEW_DEX", "SOME_NEW_DEX"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			event := &WebhookEvent{Source: tt.source}
+			if got := event.DEX(); got != tt.want {
+				t.Errorf("DEX() = %q, want %q", got, tt.want)
+			}
+			if event.Source != tt.source {
+				t.Errorf("Source = %q, want raw %q", event.Source, tt.source)
+			}
+		})
+	}
+}
+
+func TestWebhookEvent_IsSwap(t *testing.T) {
+	if !(&WebhookEvent{Type: "SWAP"}).IsSwap() {
+		t.Error("IsSwap() = false for SWAP")
+	}
+	if (&WebhookEvent{Type: "TRANSFER"}).IsSwap() {
+		t.Error("IsSwap() = true for TRANSFER")
+	}
+}
+
+func TestWebhookEvent_Counterparties(t *testing.T) {
+	t.Run("simple transfer", func(t *testing.T) {
+		event := &WebhookEvent{
+			FeePayer: "alice",
+			NativeTransfers: []NativeTransfer{
+				{FromUserAccount: "alice", ToUserAccount: "bob", Amount: 1_000_000},
+			},
+		}
+
+		if got := event.Counterparties("alice"); !reflect.DeepEqual(got, []string{"bob"}) {
+			t.Errorf("Counterparties(alice) = %v, want [bob]", got)
+		}
+		if got := event.Counterparties("bob"); !reflect.DeepEqual(got, []string{"alice"}) {
+			t.Errorf("Counterparties(bob) = %v, want [alice]", got)
+		}
+		if got := event.Counterparties("carol"); got != nil {
+			t.Errorf("Counterparties(carol) = %v, want nil", got)
+		}
+	})
+
+	t.Run("multi-hop swap", func(t *testing.T) {
+		event := &WebhookEvent{
+			FeePayer: "relayer",
+			NativeTransfers: []NativeTransfer{
+				{FromUserAccount: "trader", ToUserAccount: "trader", Amount: 2039280},
+				{FromUserAccount: "pool-b", ToUserAccount: "trader", Amount: 500_000_000},
+				{FromUserAccount: "trader", ToUserAccount: "11111111111111111111111111111111", Amount: 890880},
+			},
+			TokenTransfers: []TokenTransfer{
+				{FromUserAccount: "trader", ToUserAccount: "pool-a", Mint: "usdc", TokenAmount: 100},
+				{FromUserAccount: "pool-a", ToUserAccount: "pool-b", Mint: "bonk", TokenAmount: 5_000_000},
+				{FromUserAccount: "trader", ToUserAccount: "pool-a", Mint: "usdc", TokenAmount: 0.5},
+				{FromUserAccount: "", ToUserAccount: "trader", Mint: "lp-token", TokenAmount: 1},
+			},
+		}
+
+		want := []string{"pool-b", "pool-a"}
+		if got := event.Counterparties("trader"); !reflect.DeepEqual(got, want) {
+			t.Errorf("Counterparties(trader) = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestFilterWebhookEvents(t *testing.T) {
+	events := []WebhookEvent{
+		{Signature: "tx1", Type: "SWAP"},
+		{Signature: "tx2", Type: "TRANSFER"},
+		{Signature: "tx3", Type: "NFT_SALE"},
+		{Signature: "tx4", Type: "SWAP"},
+	}
+
+	t.Run("single type", func(t *testing.T) {
+		got := FilterWebhookEvents(events, TransactionTypeSwap)
+		if len(got) != 2 {
+			t.Fatalf("len(events) = %d, want 2", len(got))
+		}
+		if got[0].Signature != "tx1" || got[1].Signature != "tx4" {
+			t.Errorf("signatures = %s, %s, want tx1, tx4", got[0].Signature, got[1].Signature)
+		}
+	})
+
+	t.Run("multiple types", func(t *testing.T) {
+		got := FilterWebhookEvents(events, TransactionTypeTransfer, TransactionTypeNFTSale)
+		if len(got) != 2 {
+			t.Fatalf("len(events) = %d, want 2", len(got))
+		}
+		if got[0].Signature != "tx2" || got[1].Signature != "tx3" {
+			t.Errorf("signatures = %s, %s, want tx2, tx3", got[0].Signature, got[1].Signature)
+		}
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		got := FilterWebhookEvents(events, TransactionTypeNFTBid)
+		if len(got) != 0 {
+			t.Errorf("len(events) = %d, want 0", len(got))
+		}
+	})
+
+	t.Run("no types", func(t *testing.T) {
+		got := FilterWebhookEvents(events)
+		if len(got) != len(events) {
+			t.Errorf("len(events) = %d, want %d", len(got), len(events))
+		}
+	})
+}
+
+func TestSummarizeWebhookEvents(t *testing.T) {
+	events := []WebhookEvent{
+		{Signature: "tx1", Type: "SWAP", Source: "JUPITER", Fee: 5000},
+		{Signature: "tx2", Type: "SWAP", Source: "RAYDIUM", Fee: 7000},
+		{Signature: "tx3", Type: "NFT_SALE", Source: "MAGIC_EDEN", Fee: 10000},
+		{Signature: "tx4", Type: "SWAP", Source: "JUPITER", Fee: 5000},
+		{Signature: "tx5"},
+	}
+
+	summary := SummarizeWebhookEvents(events)
+	if summary.Count != 5 {
+		t.Errorf("Count = %d, want 5", summary.Count)
+	}
+	if summary.TotalFeeLamports != 27000 {
+		t.Errorf("TotalFeeLamports = %d, want 27000", summary.TotalFeeLamports)
+	}
+	if want := map[string]int{"SWAP": 3, "NFT_SALE": 1, "": 1}; !reflect.DeepEqual(summary.ByType, want) {
+		t.Errorf("ByType = %v, want %v", summary.ByType, want)
+	}
+	if want := map[string]int{"JUPITER": 2, "RAYDIUM": 1, "MAGIC_EDEN": 1, "": 1}; !reflect.DeepEqual(summary.BySource, want) {
+		t.Errorf("BySource = %v, want %v", summary.BySource, want)
+	}
+
+	empty := SummarizeWebhookEvents(nil)
+	if empty.Count != 0 || empty.ByType == nil || empty.BySource == nil {
+		t.Errorf("SummarizeWebhookEvents(nil) = %+v, want zero counts and non-nil maps", empty)
+	}
+}
+
+func TestWebhookHandler(t *testing.T) {
+	secret := "my-webhook-secret"
+	body := []byte(`[{"signature":"tx1","type":"SWAP"},{"signature":"tx2","type":"TRANSFER"}]`)
+
+	sign := func(b []byte) string {
+		h := hmac.New(sha256.New, []byte(secret))
+		h.Write(b)
+		return hex.EncodeToString(h.Sum(nil))
+	}
+
+	t.Run("valid signature", func(t *testing.T) {
+		var got []WebhookEvent
+		handler := WebhookHandler(secret, func(ctx context.Context, events []WebhookEvent) error {
+			got = events
+			return nil
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+		req.Header.Set(WebhookSignatureHeader, sign(body))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+		}
+		if len(got) != 2 {
+			t.Fatalf("len(events) = %d, want 2", len(got))
+		}
+		if got[0].Signature != "tx1" {
+			t.Errorf("events[0].Signature = %s, want tx1", got[0].Signature)
+		}
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		called := false
+		handler := WebhookHandler(secret, func(ctx context.Context, events []WebhookEvent) error {
+			called = true
+			return nil
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+		req.Header.Set(WebhookSignatureHeader, "bad-signature")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+		}
+		if called {
+			t.Error("handler should not be called for invalid signature")
+		}
+	})
+
+	t.Run("missing signature", func(t *testing.T) {
+		handler := WebhookHandler(secret, func(ctx context.Context, events []WebhookEvent) error {
+			return nil
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+		}
+	})
+
+	t.Run("invalid payload", func(t *testing.T) {
+		handler := WebhookHandler(secret, func(ctx context.Context, events []WebhookEvent) error {
+			return nil
+		})
+
+		bad := []byte(`{invalid}`)
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(bad))
+		req.Header.Set(WebhookSignatureHeader, sign(bad))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("handler error", func(t *testing.T) {
+		handler := WebhookHandler(secret, func(ctx context.Context, events []WebhookEvent) error {
+			return errors.New("database unavailable")
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+		req.Header.Set(WebhookSignatureHeader, sign(body))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusInternalServerError {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusInternalServerError)
+		}
+	})
+
+	t.Run("body too large", func(t *testing.T) {
+		handler := WebhookHandler(secret, func(ctx context.Context, events []WebhookEvent) error {
+			return nil
+		})
+
+		large := bytes.Repeat([]byte("a"), maxWebhookBodySize+1)
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(large))
+		req.Header.Set(WebhookSignatureHeader, sign(large))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusRequestEntityTooLarge {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusRequestEntityTooLarge)
+		}
+	})
+}
+
+func TestValidateWebhookSignatureAny(t *testing.T) {
+	body := []byte(`{"signature":"abc123","type":"SWAP"}`)
+	sign := func(secret string) string {
+		h := hmac.New(sha256.New, []byte(secret))
+		h.Write(body)
+		return hex.EncodeToString(h.Sum(nil))
+	}
+
+	tests := []struct {
+		name      string
+		signature string
+		secrets   []string
+		want      bool
+	}{
+		{"signed with old secret", sign("old-secret"), []string{"old-secret", "new-secret"}, true},
+		{"signed with new secret", sign("new-secret"), []string{"old-secret", "new-secret"}, true},
+		{"signed with neither", sign("other-secret"), []string{"old-secret", "new-secret"}, false},
+		{"empty secrets skipped", sign("new-secret"), []string{"", "new-secret"}, true},
+		{"only empty secrets", sign(""), []string{""}, false},
+		{"no secrets", sign("old-secret"), nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValidateWebhookSignatureAny(body, tt.signature, tt.secrets...); got != tt.want {
+				t.Errorf("ValidateWebhookSignatureAny() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateWebhookSignatureEnc(t *testing.T) {
+	secret := "my-webhook-secret"
+	body := []byte(`{"signature":"abc123","type":"SWAP"}`)
+
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write(body)
+	mac := h.Sum(nil)
+	hexSig := hex.EncodeToString(mac)
+	b64Sig := base64.StdEncoding.EncodeToString(mac)
+
+	tests := []struct {
+		name      string
+		signature string
+		enc       SignatureEncoding
+		want      bool
+	}{
+		{"hex", hexSig, SignatureEncodingHex, true},
+		{"hex with prefix", "sha256=" + hexSig, SignatureEncodingHex, true},
+		{"base64", b64Sig, SignatureEncodingBase64, true},
+		{"base64 with prefix", "sha256=" + b64Sig, SignatureEncodingBase64, true},
+		{"hex signature as base64", hexSig, SignatureEncodingBase64, false},
+		{"base64 signature as hex", b64Sig, SignatureEncodingHex, false},
+		{"invalid hex", "zz-not-hex", SignatureEncodingHex, false},
+		{"invalid base64", "!!not-base64!!", SignatureEncodingBase64, false},
+		{"unknown encoding", hexSig, SignatureEncoding(99), false},
+		{"empty signature", "", SignatureEncodingBase64, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValidateWebhookSignatureEnc(body, tt.signature, secret, tt.enc); got != tt.want {
+				t.Errorf("ValidateWebhookSignatureEnc() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("empty secret", func(t *testing.T) {
+		if ValidateWebhookSignatureEnc(body, b64Sig, "", SignatureEncodingBase64) {
+			t.Error("ValidateWebhookSignatureEnc should return false for empty secret")
+		}
+	})
+}
+
+func TestWebhook_ToCreateRequest(t *testing.T) {
+	webhook := &Webhook{
+		WebhookID:        "webhook-123",
+		Wallet:           "wallet-abc",
+		WebhookURL:       "https://staging.example.com/webhook",
+		TransactionTypes: []TransactionType{TransactionTypeSwap, TransactionTypeTransfer},
+		AccountAddresses: []string{"address1", "address2"},
+		WebhookType:      WebhookTypeRaw,
+		AuthHeader:       "Bearer token",
+	}
+
+	req := webhook.ToCreateRequest()
+
+	if req.WebhookURL != webhook.WebhookURL {
+		t.Errorf("WebhookURL = %s, want %s", req.WebhookURL, webhook.WebhookURL)
+	}
+	if req.WebhookType != WebhookTypeRaw {
+		t.Errorf("WebhookType = %s, want raw", req.WebhookType)
+	}
+	if req.AuthHeader != "Bearer token" {
+		t.Errorf("AuthHeader = %s, want Bearer token", req.AuthHeader)
+	}
+	if len(req.TransactionTypes) != 2 || len(req.AccountAddresses) != 2 {
+		t.Fatalf("slices not copied: %v, %v", req.TransactionTypes, req.AccountAddresses)
+	}
+
+	req.TransactionTypes[0] = TransactionTypeNFTSale
+	req.AccountAddresses[0] = "changed"
+	req.AccountAddresses = append(req.AccountAddresses, "address3")
+
+	if webhook.TransactionTypes[0] != TransactionTypeSwap {
+		t.Error("modifying request TransactionTypes should not affect webhook")
+	}
+	if webhook.AccountAddresses[0] != "address1" {
+		t.Error("modifying request AccountAddresses should not affect webhook")
+	}
+	if len(webhook.AccountAddresses) != 2 {
+		t.Error("appending to request AccountAddresses should not affect webhook")
+	}
+}
+
+func TestWebhookDeduper(t *testing.T) {
+	t.Run("detects duplicates", func(t *testing.T) {
+		d := NewWebhookDeduper(10)
+		if d.Seen("tx1") {
+			t.Error("Seen(tx1) should be false on first call")
+		}
+		if !d.Seen("tx1") {
+			t.Error("Seen(tx1) should be true on second call")
+		}
+		if d.Seen("tx2") {
+			t.Error("Seen(tx2) should be false on first call")
+		}
+	})
+
+	t.Run("evicts least recently seen", func(t *testing.T) {
+		d := NewWebhookDeduper(2)
+		d.Seen("tx1")
+		d.Seen("tx2")
+		d.Seen("tx1") // refresh tx1, tx2 is now oldest
+		d.Seen("tx3") // evicts tx2
+
+		if d.Len() != 2 {
+			t.Errorf("Len() = %d, want 2", d.Len())
+		}
+		if !d.Seen("tx1") {
+			t.Error("tx1 should still be remembered")
+		}
+		if !d.Seen("tx3") {
+			t.Error("tx3 should still be remembered")
+		}
+		if d.Seen("tx2") {
+			t.Error("tx2 should have been evicted")
+		}
+	})
+
+	t.Run("default capacity", func(t *testing.T) {
+		d := NewWebhookDeduper(0)
+		if d.capacity != DefaultDeduperCapacity {
+			t.Errorf("capacity = %d, want %d", d.capacity, DefaultDeduperCapacity)
+		}
+	})
+
+	t.Run("concurrent use", func(t *testing.T) {
+		d := NewWebhookDeduper(100)
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		firstSeen := 0
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 50; j++ {
+					if !d.Seen(fmt.Sprintf("tx%d", j)) {
+						mu.Lock()
+						firstSeen++
+						mu.Unlock()
+					}
+				}
+			}()
+		}
+		wg.Wait()
+
+		if firstSeen != 50 {
+			t.Errorf("first sightings = %d, want 50", firstSeen)
+		}
+	})
+}